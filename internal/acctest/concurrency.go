@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// defaultConcurrencyLimits maps resource types to the number that tests may use concurrently.
+// The limits reflect the default AWS quotas, or the rate at which the resources can be created.
+//
+//go:embed concurrency_limits.json
+var defaultConcurrencyLimits []byte
+
+// concurrencyGovernor limits the number of resources of each type that concurrently running tests use.
+// A test acquires all of its slots at once, so tests waiting for slots cannot deadlock.
+type concurrencyGovernor struct {
+	limits map[string]int
+
+	mu    sync.Mutex
+	cond  *sync.Cond
+	inUse map[string]int
+}
+
+func newConcurrencyGovernor(limits map[string]int) *concurrencyGovernor {
+	g := &concurrencyGovernor{
+		limits: limits,
+		inUse:  make(map[string]int),
+	}
+	g.cond = sync.NewCond(&g.mu)
+
+	return g
+}
+
+// slots returns the number of slots of each limited resource type required for the specified resource counts.
+// Requests for more than a resource type's limit are capped at the limit.
+// The resource types whose limit is 0 are also returned.
+func (g *concurrencyGovernor) slots(counts map[string]int) (map[string]int, []string) {
+	slots := make(map[string]int)
+	var disabled []string
+
+	for resourceType, n := range counts {
+		limit, ok := g.limits[resourceType]
+		if !ok {
+			continue
+		}
+
+		if limit <= 0 {
+			disabled = append(disabled, resourceType)
+			continue
+		}
+
+		slots[resourceType] = min(n, limit)
+	}
+
+	slices.Sort(disabled)
+
+	return slots, disabled
+}
+
+func (g *concurrencyGovernor) available(slots map[string]int) bool {
+	for resourceType, n := range slots {
+		if g.inUse[resourceType]+n > g.limits[resourceType] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// acquire waits until all the specified slots are available and then takes them.
+// The returned function releases the slots.
+func (g *concurrencyGovernor) acquire(slots map[string]int, wait func()) func() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.available(slots) {
+		wait()
+
+		for !g.available(slots) {
+			g.cond.Wait()
+		}
+	}
+
+	for resourceType, n := range slots {
+		g.inUse[resourceType] += n
+	}
+
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		for resourceType, n := range slots {
+			g.inUse[resourceType] -= n
+		}
+
+		g.cond.Broadcast()
+	}
+}
+
+var concurrency = sync.OnceValues(func() (*concurrencyGovernor, error) {
+	limits, err := readConcurrencyLimits()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newConcurrencyGovernor(limits), nil
+})
+
+// readConcurrencyLimits reads the resource type limits from the file specified in the environment, or the defaults.
+func readConcurrencyLimits() (map[string]int, error) {
+	data := defaultConcurrencyLimits
+	source := "default concurrency limits"
+
+	if v := os.Getenv(envvar.AccConcurrencyLimitsFile); v != "" {
+		var err error
+		data, err = os.ReadFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading %s (%s): %w", envvar.AccConcurrencyLimitsFile, v, err)
+		}
+
+		source = v
+	}
+
+	var limits map[string]int
+
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", source, err)
+	}
+
+	return limits, nil
+}
+
+// testCaseResourceCounts returns the number of each resource type declared in a test case's configurations,
+// taking into account any `count` or `for_each` meta-arguments.
+// The steps run one after another, so the count for a resource type is its largest count in any step.
+// Configurations that cannot be parsed are ignored.
+func testCaseResourceCounts(c resource.TestCase) map[string]int {
+	counts := make(map[string]int)
+
+	for _, step := range c.Steps {
+		for resourceType, n := range configResourceCounts(step.Config) {
+			counts[resourceType] = max(counts[resourceType], n)
+		}
+	}
+
+	return counts
+}
+
+func configResourceCounts(config string) map[string]int {
+	counts := make(map[string]int)
+
+	if strings.TrimSpace(config) == "" {
+		return counts
+	}
+
+	file, diags := hclsyntax.ParseConfig([]byte(config), "config.tf", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		return counts
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	if !ok {
+		return counts
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == "resource" && len(block.Labels) > 0 {
+			if n := resourceBlockInstances(block); n > 0 {
+				counts[block.Labels[0]] += n
+			}
+		}
+	}
+
+	return counts
+}
+
+// countEvalContext evaluates `count` and `for_each` expressions.
+// There are no variables, so only literal values and the commonly used functions are supported.
+var countEvalContext = &hcl.EvalContext{
+	Functions: map[string]function.Function{
+		"length": stdlib.LengthFunc,
+		"range":  stdlib.RangeFunc,
+		"tolist": stdlib.MakeToFunc(cty.List(cty.DynamicPseudoType)),
+		"tomap":  stdlib.MakeToFunc(cty.Map(cty.DynamicPseudoType)),
+		"toset":  stdlib.MakeToFunc(cty.Set(cty.DynamicPseudoType)),
+	},
+}
+
+// resourceBlockInstances returns the number of instances declared by a resource block's `count` or `for_each` meta-argument.
+// A single instance is assumed if neither is present or the value cannot be determined from the configuration alone.
+func resourceBlockInstances(block *hclsyntax.Block) int {
+	if attr, ok := block.Body.Attributes["count"]; ok {
+		v, diags := attr.Expr.Value(countEvalContext)
+
+		if diags.HasErrors() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.Number) {
+			return 1
+		}
+
+		n, accuracy := v.AsBigFloat().Int64()
+
+		if accuracy != big.Exact || n < 0 {
+			return 1
+		}
+
+		return int(n)
+	}
+
+	if attr, ok := block.Body.Attributes["for_each"]; ok {
+		v, diags := attr.Expr.Value(countEvalContext)
+
+		if diags.HasErrors() || !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+			return 1
+		}
+
+		return v.LengthInt()
+	}
+
+	return 1
+}
+
+// acquireConcurrencySlots waits until the resources used by a test case are within the concurrency limits.
+// The test is skipped if the limit for any of its resource types is 0.
+// The returned function releases the slots.
+func acquireConcurrencySlots(t *testing.T, c resource.TestCase) func() {
+	t.Helper()
+
+	g, err := concurrency()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slots, disabled := g.slots(testCaseResourceCounts(c))
+
+	if len(disabled) > 0 {
+		t.Skipf("concurrency for %s testing set to 0", strings.Join(disabled, ", "))
+	}
+
+	if len(slots) == 0 {
+		return func() {}
+	}
+
+	return g.acquire(slots, func() {
+		t.Logf("waiting for concurrency slots: %v", slots)
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestTestCaseResourceCounts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		testCase resource.TestCase
+		expected map[string]int
+	}{
+		"no steps": {
+			expected: map[string]int{},
+		},
+		"single step": {
+			testCase: resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: `
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.1.1.0/24"
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.2.0.0/16"
+}
+`,
+					},
+				},
+			},
+			expected: map[string]int{
+				"aws_subnet": 1,
+				"aws_vpc":    2,
+			},
+		},
+		"multiple steps": {
+			testCase: resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: `
+resource "aws_eip" "test" {}
+`,
+					},
+					{
+						Config: `
+resource "aws_eip" "test" {}
+
+resource "aws_eip" "test2" {}
+`,
+					},
+					{
+						ResourceName: "aws_eip.test",
+						ImportState:  true,
+					},
+				},
+			},
+			expected: map[string]int{
+				"aws_eip": 2,
+			},
+		},
+		"count and for_each": {
+			testCase: resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: `
+variable "subnet_count" {
+  default = 2
+}
+
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.${count.index}.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count = var.subnet_count
+
+  vpc_id     = aws_vpc.test[0].id
+  cidr_block = cidrsubnet(aws_vpc.test[0].cidr_block, 8, count.index)
+}
+
+resource "aws_eip" "disabled" {
+  count = 0
+}
+
+resource "aws_eip" "test" {
+  for_each = toset(["a", "b", "c", "d"])
+}
+
+resource "aws_security_group" "test" {
+  for_each = {
+    web = "10.0.0.0/8"
+    db  = "172.16.0.0/12"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  for_each = toset(aws_vpc.test[*].id)
+}
+`,
+					},
+				},
+			},
+			expected: map[string]int{
+				"aws_eip":              4,
+				"aws_internet_gateway": 1,
+				"aws_security_group":   2,
+				"aws_subnet":           1,
+				"aws_vpc":              3,
+			},
+		},
+		"invalid configuration": {
+			testCase: resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: `resource "aws_vpc" "test" {`,
+					},
+				},
+			},
+			expected: map[string]int{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.TestCaseResourceCounts(testCase.testCase)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConcurrencyGovernorSlots(t *testing.T) {
+	t.Parallel()
+
+	g := acctest.NewConcurrencyGovernor(map[string]int{
+		"aws_eip":                   2,
+		"aws_organizations_account": 0,
+		"aws_vpc":                   5,
+	})
+
+	slots, disabled := acctest.ConcurrencyGovernorSlots(g, map[string]int{
+		"aws_eip":    3,
+		"aws_subnet": 4,
+		"aws_vpc":    1,
+	})
+
+	if diff := cmp.Diff(slots, map[string]int{"aws_eip": 2, "aws_vpc": 1}); diff != "" {
+		t.Errorf("unexpected slots diff (+wanted, -got): %s", diff)
+	}
+	if len(disabled) != 0 {
+		t.Errorf("unexpected disabled resource types: %v", disabled)
+	}
+
+	_, disabled = acctest.ConcurrencyGovernorSlots(g, map[string]int{
+		"aws_organizations_account": 1,
+	})
+
+	if diff := cmp.Diff(disabled, []string{"aws_organizations_account"}); diff != "" {
+		t.Errorf("unexpected disabled diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestConcurrencyGovernorAcquire(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limit = 2
+		n     = 10
+	)
+
+	g := acctest.NewConcurrencyGovernor(map[string]int{
+		"aws_eip": limit,
+		"aws_vpc": limit,
+	})
+
+	var inUse, maxInUse, waited int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Alternate the resource types' order to check that partially acquired slots cannot deadlock.
+			slots := map[string]int{"aws_eip": 1, "aws_vpc": 1 + i%2}
+
+			release := acctest.ConcurrencyGovernorAcquire(g, slots, func() {
+				atomic.AddInt32(&waited, 1)
+			})
+			defer release()
+
+			v := atomic.AddInt32(&inUse, int32(slots["aws_vpc"]))
+			for {
+				m := atomic.LoadInt32(&maxInUse)
+				if v <= m || atomic.CompareAndSwapInt32(&maxInUse, m, v) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			atomic.AddInt32(&inUse, -int32(slots["aws_vpc"]))
+		}(i)
+	}
+
+	wg.Wait()
+
+	if v := atomic.LoadInt32(&maxInUse); v > limit {
+		t.Errorf("%d VPC slots in use concurrently, limit is %d", v, limit)
+	}
+	if atomic.LoadInt32(&waited) == 0 {
+		t.Error("expected some acquisitions to wait")
+	}
+}
+
+func TestReadConcurrencyLimits(t *testing.T) { //nolint:paralleltest
+	limits, err := acctest.ReadConcurrencyLimits()
+
+	if err != nil {
+		t.Fatalf("reading default limits: %s", err)
+	}
+	if limits["aws_vpc"] == 0 {
+		t.Error("expected a default aws_vpc limit")
+	}
+
+	path := filepath.Join(t.TempDir(), "limits.json")
+	if err := os.WriteFile(path, []byte(`{"aws_vpc": 20}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(envvar.AccConcurrencyLimitsFile, path)
+
+	limits, err = acctest.ReadConcurrencyLimits()
+
+	if err != nil {
+		t.Fatalf("reading limits: %s", err)
+	}
+	if diff := cmp.Diff(limits, map[string]int{"aws_vpc": 20}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"aws_vpc": "twenty"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := acctest.ReadConcurrencyLimits(); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -5,5 +5,22 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder           = closeVCRRecorder
+	ConcurrencyGovernorAcquire = (*concurrencyGovernor).acquire
+	ConcurrencyGovernorSlots   = (*concurrencyGovernor).slots
+	NewConcurrencyGovernor     = newConcurrencyGovernor
+	NewVCRRedactor             = newVCRRedactor
+	ReadConcurrencyLimits      = readConcurrencyLimits
+	TestCaseResourceCounts     = testCaseResourceCounts
+	VCRRedactInteraction       = (*vcrRedactor).redactInteraction
+	VCRRequestFingerprint      = vcrRequestFingerprint
+	VCRRequestMatcher          = vcrRequestMatcher
+)
+
+const (
+	VCRSequenceHeader = vcrSequenceHeader
+)
+
+type (
+	VCRSequenceContextKey = vcrSequenceContextKey
 )
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	standInRequestID = "c4a9e7e2-5f4e-4c1e-9b0e-7d3a8f2b1c6d"
+)
+
+// standInProtocol is the AWS protocol used by an operation handled by a StandIn.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+type standInProtocol int
+
+const (
+	standInProtocolJSON standInProtocol = iota
+	standInProtocolQuery
+	standInProtocolEC2Query
+	standInProtocolRESTXML
+)
+
+// StandInResponse is a scripted response to an AWS API operation.
+type StandInResponse struct {
+	// StatusCode is the HTTP status code. Defaults to 200, or to 400 if ErrorCode is set.
+	StatusCode int
+	// Headers are additional HTTP response headers.
+	Headers map[string]string
+	// Body is the response body.
+	// For the JSON protocols, values other than string or []byte are marshaled as JSON.
+	// For the other protocols, the body is the complete XML response.
+	Body any
+	// ErrorCode, if set, returns an error response with this code, e.g. "ResourceNotFoundException".
+	// The error response body is generated in the operation's protocol.
+	ErrorCode string
+	// ErrorMessage is the message of an error response.
+	ErrorMessage string
+}
+
+// StandInRequest is a request received by a StandIn.
+type StandInRequest struct {
+	Service   string
+	Operation string
+	Method    string
+	Path      string
+	Header    http.Header
+	Body      string
+}
+
+type standInHandler struct {
+	service   string
+	operation string
+	protocol  standInProtocol
+	method    string         // REST protocols only.
+	path      *regexp.Regexp // REST protocols only.
+	responses []StandInResponse
+	calls     int
+}
+
+// StandIn is an in-process HTTP server that stands in for AWS APIs, allowing CRUD handlers,
+// waiters, retries and not-found handling to be unit tested without AWS credentials.
+//
+// Register scripted responses for each service and operation used by the code under test,
+// then include ProviderConfig in the test configuration:
+//
+//	standIn := acctest.NewStandIn(t)
+//	standIn.HandleJSON(names.SecretsManager, "DescribeSecret", acctest.StandInResponse{ErrorCode: "ResourceNotFoundException"})
+//
+//	resource.UnitTest(t, resource.TestCase{
+//		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+//		Steps: []resource.TestStep{
+//			{
+//				Config: acctest.ConfigCompose(standIn.ProviderConfig(), testConfig),
+//			},
+//		},
+//	})
+//
+// Services are identified by their provider `endpoints` argument name, e.g. "secretsmanager".
+// Each operation's responses are returned in order, with the last response repeated for any later
+// requests, so that polling waiters can be scripted. Unhandled requests fail the test.
+type StandIn struct {
+	handlers []*standInHandler
+	lock     sync.Mutex
+	requests []StandInRequest
+	server   *httptest.Server
+	t        *testing.T
+}
+
+// NewStandIn starts a StandIn, which is closed when the test completes.
+func NewStandIn(t *testing.T) *StandIn {
+	t.Helper()
+
+	s := &StandIn{
+		t: t,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// HandleJSON registers responses to an operation of a service using the awsJson1_0 or awsJson1_1 protocols.
+// The operation is identified by the X-Amz-Target request header.
+func (s *StandIn) HandleJSON(service, operation string, responses ...StandInResponse) {
+	s.handle(&standInHandler{
+		service:   service,
+		operation: operation,
+		protocol:  standInProtocolJSON,
+		responses: responses,
+	})
+}
+
+// HandleQuery registers responses to an operation of a service using the awsQuery protocol, e.g. IAM or STS.
+// The operation is identified by the Action request parameter.
+func (s *StandIn) HandleQuery(service, operation string, responses ...StandInResponse) {
+	s.handle(&standInHandler{
+		service:   service,
+		operation: operation,
+		protocol:  standInProtocolQuery,
+		responses: responses,
+	})
+}
+
+// HandleEC2Query registers responses to an operation of a service using the ec2Query protocol.
+// The operation is identified by the Action request parameter.
+func (s *StandIn) HandleEC2Query(service, operation string, responses ...StandInResponse) {
+	s.handle(&standInHandler{
+		service:   service,
+		operation: operation,
+		protocol:  standInProtocolEC2Query,
+		responses: responses,
+	})
+}
+
+// HandleRESTXML registers responses to an operation of a service using the restXml protocol, e.g. S3 or Route 53.
+// The operation is identified by the HTTP method and a regular expression matching the request path, e.g. `^/example-bucket$`.
+func (s *StandIn) HandleRESTXML(service, operation, method string, path *regexp.Regexp, responses ...StandInResponse) {
+	s.handle(&standInHandler{
+		service:   service,
+		operation: operation,
+		protocol:  standInProtocolRESTXML,
+		method:    method,
+		path:      path,
+		responses: responses,
+	})
+}
+
+func (s *StandIn) handle(h *standInHandler) {
+	s.t.Helper()
+
+	if len(h.responses) == 0 {
+		s.t.Fatalf("no responses registered for %s %s", h.service, h.operation)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.handlers = append(s.handlers, h)
+}
+
+// Endpoint returns the URL of the specified service's stand-in endpoint.
+func (s *StandIn) Endpoint(service string) string {
+	return s.server.URL + "/" + service
+}
+
+// ProviderConfig returns a provider configuration that sends requests for every service with
+// registered responses to the stand-in, and makes no other requests to AWS.
+func (s *StandIn) ProviderConfig() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var services []string
+	for _, h := range s.handlers {
+		services = append(services, h.service)
+	}
+	slices.Sort(services)
+	services = slices.Compact(services)
+
+	var endpoints strings.Builder
+	for _, service := range services {
+		fmt.Fprintf(&endpoints, "    %[1]s = %[2]q\n", service, s.Endpoint(service))
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  region                      = %[1]q
+  access_key                  = "mock_access_key"
+  secret_key                  = "mock_secret_key"
+  max_retries                 = 1
+  s3_use_path_style           = true
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+%[2]s  }
+}
+`, names.USWest2RegionID, endpoints.String())
+}
+
+// Requests returns the requests received for the specified service and operation.
+func (s *StandIn) Requests(service, operation string) []StandInRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var requests []StandInRequest
+	for _, r := range s.requests {
+		if r.Service == service && r.Operation == operation {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+func (s *StandIn) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.t.Errorf("reading request body: %s", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body := string(b)
+
+	// The service is the first path segment.
+	service, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	path = "/" + path
+
+	var target, action string
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		target = v[strings.LastIndex(v, ".")+1:]
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(body); err == nil {
+			action = v.Get("Action")
+		}
+	}
+
+	s.lock.Lock()
+
+	var handler *standInHandler
+	for _, h := range s.handlers {
+		if h.service != service {
+			continue
+		}
+
+		var ok bool
+		switch h.protocol {
+		case standInProtocolJSON:
+			ok = h.operation == target
+		case standInProtocolQuery, standInProtocolEC2Query:
+			ok = h.operation == action
+		case standInProtocolRESTXML:
+			ok = h.method == r.Method && h.path.MatchString(path)
+		}
+
+		if ok {
+			handler = h
+			break
+		}
+	}
+
+	request := StandInRequest{
+		Service: service,
+		Method:  r.Method,
+		Path:    path,
+		Header:  r.Header.Clone(),
+		Body:    body,
+	}
+
+	var response StandInResponse
+	if handler != nil {
+		request.Operation = handler.operation
+		response = handler.responses[min(handler.calls, len(handler.responses)-1)]
+		handler.calls++
+	}
+
+	s.requests = append(s.requests, request)
+
+	s.lock.Unlock()
+
+	if handler == nil {
+		s.t.Errorf("unhandled AWS API request: %s %s (service: %q, X-Amz-Target: %q, Action: %q)", r.Method, r.URL.Path, service, r.Header.Get("X-Amz-Target"), action)
+		http.Error(w, "unhandled request", http.StatusNotImplemented)
+		return
+	}
+
+	s.write(w, handler, response)
+}
+
+func (s *StandIn) write(w http.ResponseWriter, h *standInHandler, response StandInResponse) {
+	var contentType string
+	var body []byte
+
+	switch h.protocol {
+	case standInProtocolJSON:
+		contentType = "application/x-amz-json-1.1"
+	default:
+		contentType = "text/xml"
+	}
+
+	statusCode := response.StatusCode
+	if response.ErrorCode != "" {
+		if statusCode == 0 {
+			statusCode = http.StatusBadRequest
+		}
+		body = standInErrorBody(h, response.ErrorCode, response.ErrorMessage)
+		if h.protocol == standInProtocolJSON {
+			w.Header().Set("X-Amzn-Errortype", response.ErrorCode)
+		}
+	} else {
+		switch v := response.Body.(type) {
+		case nil:
+			if h.protocol == standInProtocolJSON {
+				body = []byte("{}")
+			}
+		case string:
+			body = []byte(v)
+		case []byte:
+			body = v
+		default:
+			var err error
+			if body, err = json.Marshal(v); err != nil {
+				s.t.Errorf("marshaling %s %s response: %s", h.service, h.operation, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", standInRequestID)
+	w.Header().Set("X-Amz-Request-Id", standInRequestID)
+	for k, v := range response.Headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(statusCode)
+	w.Write(body) //nolint:errcheck // The client may have gone away.
+}
+
+// standInErrorBody returns an error response body in the operation's protocol.
+func standInErrorBody(h *standInHandler, code, message string) []byte {
+	if h.protocol == standInProtocolJSON {
+		b, _ := json.Marshal(map[string]string{
+			"__type":  code,
+			"message": message,
+		})
+		return b
+	}
+
+	var escapedMessage bytes.Buffer
+	xml.EscapeText(&escapedMessage, []byte(message)) //nolint:errcheck // Writing to a bytes.Buffer can't fail.
+
+	switch {
+	case h.protocol == standInProtocolEC2Query:
+		return []byte(fmt.Sprintf(`<Response><Errors><Error><Code>%[1]s</Code><Message>%[2]s</Message></Error></Errors><RequestID>%[3]s</RequestID></Response>`, code, escapedMessage.String(), standInRequestID))
+	case h.protocol == standInProtocolRESTXML && (h.service == names.S3 || h.service == names.S3Control):
+		// S3 error responses are not wrapped.
+		return []byte(fmt.Sprintf(`<Error><Code>%[1]s</Code><Message>%[2]s</Message><RequestId>%[3]s</RequestId></Error>`, code, escapedMessage.String(), standInRequestID))
+	default:
+		return []byte(fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%[1]s</Code><Message>%[2]s</Message></Error><RequestId>%[3]s</RequestId></ErrorResponse>`, code, escapedMessage.String(), standInRequestID))
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func standInConfig(s *acctest.StandIn, service string) aws.Config {
+	return aws.Config{
+		BaseEndpoint:     aws.String(s.Endpoint(service)),
+		Credentials:      credentials.NewStaticCredentialsProvider("mock_access_key", "mock_secret_key", ""),
+		Region:           names.USWest2RegionID,
+		RetryMaxAttempts: 1,
+	}
+}
+
+func TestStandInJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := acctest.NewStandIn(t)
+	s.HandleJSON(names.SecretsManager, "DescribeSecret",
+		acctest.StandInResponse{Body: map[string]any{"Name": "example", "DeletedDate": nil}},
+		acctest.StandInResponse{ErrorCode: "ResourceNotFoundException", ErrorMessage: "Secrets Manager can't find the specified secret."},
+	)
+
+	conn := secretsmanager.NewFromConfig(standInConfig(s, names.SecretsManager))
+
+	output, err := conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String("example")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, expected := aws.ToString(output.Name), "example"; got != expected {
+		t.Errorf("Name: got %q, expected %q", got, expected)
+	}
+
+	// The last response is repeated.
+	for range 2 {
+		_, err = conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String("example")})
+		if !tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
+			t.Errorf("expected ResourceNotFoundException, got %v", err)
+		}
+	}
+
+	requests := s.Requests(names.SecretsManager, "DescribeSecret")
+	if got, expected := len(requests), 3; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+	if !strings.Contains(requests[0].Body, `"SecretId":"example"`) {
+		t.Errorf("unexpected request body: %s", requests[0].Body)
+	}
+}
+
+func TestStandInQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := acctest.NewStandIn(t)
+	s.HandleQuery(names.STS, "GetCallerIdentity",
+		acctest.StandInResponse{ErrorCode: "ExpiredToken", ErrorMessage: "The security token included in the request is expired", StatusCode: http.StatusForbidden},
+		acctest.StandInResponse{Body: `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>`},
+	)
+
+	conn := sts.NewFromConfig(standInConfig(s, names.STS))
+
+	_, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if !tfawserr.ErrCodeEquals(err, "ExpiredToken") {
+		t.Errorf("expected ExpiredToken, got %v", err)
+	}
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, expected := aws.ToString(output.Account), "123456789012"; got != expected {
+		t.Errorf("Account: got %q, expected %q", got, expected)
+	}
+}
+
+func TestStandInEC2Query(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := acctest.NewStandIn(t)
+	s.HandleEC2Query(names.EC2, "DescribeVpcs",
+		acctest.StandInResponse{ErrorCode: "InvalidVpcID.NotFound", ErrorMessage: "The vpc ID 'vpc-12345678' does not exist"},
+	)
+
+	conn := ec2.NewFromConfig(standInConfig(s, names.EC2))
+
+	_, err := conn.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-12345678"}})
+	if !tfawserr.ErrCodeEquals(err, "InvalidVpcID.NotFound") {
+		t.Errorf("expected InvalidVpcID.NotFound, got %v", err)
+	}
+
+	requests := s.Requests(names.EC2, "DescribeVpcs")
+	if got, expected := len(requests), 1; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+	if !strings.Contains(requests[0].Body, "VpcId.1=vpc-12345678") {
+		t.Errorf("unexpected request body: %s", requests[0].Body)
+	}
+}
+
+func TestStandInRESTXML(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := acctest.NewStandIn(t)
+	s.HandleRESTXML(names.S3, "GetBucketTagging", http.MethodGet, regexp.MustCompile(`^/example-bucket$`),
+		acctest.StandInResponse{ErrorCode: "NoSuchTagSet", ErrorMessage: "The TagSet does not exist", StatusCode: http.StatusNotFound},
+	)
+
+	conn := s3.NewFromConfig(standInConfig(s, names.S3), func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+
+	_, err := conn.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String("example-bucket")})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchTagSet") {
+		t.Errorf("expected NoSuchTagSet, got %v", err)
+	}
+
+	if got, expected := len(s.Requests(names.S3, "GetBucketTagging")), 1; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
+
+func TestStandInProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	s := acctest.NewStandIn(t)
+	s.HandleJSON(names.SecretsManager, "DescribeSecret", acctest.StandInResponse{})
+	s.HandleJSON(names.SecretsManager, "GetResourcePolicy", acctest.StandInResponse{})
+	s.HandleQuery(names.STS, "GetCallerIdentity", acctest.StandInResponse{})
+
+	config := s.ProviderConfig()
+
+	for _, expected := range []string{
+		`secretsmanager = "` + s.Endpoint(names.SecretsManager) + `"`,
+		`sts = "` + s.Endpoint(names.STS) + `"`,
+		"skip_credentials_validation = true",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("provider configuration does not contain %q:\n%s", expected, config)
+		}
+	}
+	if got, expected := strings.Count(config, "secretsmanager ="), 1; got != expected {
+		t.Errorf("got %d secretsmanager endpoints, expected %d", got, expected)
+	}
+}
+
+// standInLogGroups returns a DescribeLogGroups response body containing the specified log groups.
+func standInLogGroups(logGroupNames ...string) map[string]any {
+	logGroups := []map[string]any{}
+	for _, name := range logGroupNames {
+		logGroups = append(logGroups, map[string]any{
+			"arn":           "arn:aws:logs:us-west-2:123456789012:log-group:" + name + ":*", //lintignore:AWSAT003,AWSAT005
+			"logGroupClass": "STANDARD",
+			"logGroupName":  name,
+		})
+	}
+
+	return map[string]any{"logGroups": logGroups}
+}
+
+// standInPreCheckTerraformCLI skips a test that runs Terraform against a StandIn if no Terraform CLI is installed,
+// as resource.UnitTest would otherwise download one.
+func standInPreCheckTerraformCLI(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("skipping test; no Terraform CLI found in TF_ACC_TERRAFORM_PATH or PATH")
+	}
+}
+
+const testAccStandInConfig_logGroup = `
+resource "aws_cloudwatch_log_group" "test" {
+  name = "tf-acc-test-stand-in"
+}
+`
+
+func TestStandInResourceLifecycle(t *testing.T) {
+	standInPreCheckTerraformCLI(t)
+
+	resourceName := "aws_cloudwatch_log_group.test"
+	s := acctest.NewStandIn(t)
+	s.HandleJSON(names.Logs, "CreateLogGroup", acctest.StandInResponse{})
+	s.HandleJSON(names.Logs, "DescribeLogGroups", acctest.StandInResponse{Body: standInLogGroups("tf-acc-test-stand-in")})
+	s.HandleJSON(names.Logs, "ListTagsLogGroup", acctest.StandInResponse{Body: map[string]any{"tags": map[string]string{}}})
+	s.HandleJSON(names.Logs, "DeleteLogGroup", acctest.StandInResponse{})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			requests := s.Requests(names.Logs, "DeleteLogGroup")
+			if got, expected := len(requests), 1; got != expected {
+				return fmt.Errorf("got %d DeleteLogGroup requests, expected %d", got, expected)
+			}
+			if !strings.Contains(requests[0].Body, `"logGroupName":"tf-acc-test-stand-in"`) {
+				return fmt.Errorf("unexpected DeleteLogGroup request body: %s", requests[0].Body)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(s.ProviderConfig(), testAccStandInConfig_logGroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, "arn:aws:logs:us-west-2:123456789012:log-group:tf-acc-test-stand-in"), //lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "tf-acc-test-stand-in"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, names.USWest2RegionID),
+					func(*terraform.State) error {
+						requests := s.Requests(names.Logs, "CreateLogGroup")
+						if got, expected := len(requests), 1; got != expected {
+							return fmt.Errorf("got %d CreateLogGroup requests, expected %d", got, expected)
+						}
+						if !strings.Contains(requests[0].Body, `"logGroupName":"tf-acc-test-stand-in"`) {
+							return fmt.Errorf("unexpected CreateLogGroup request body: %s", requests[0].Body)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestStandInResourceNotFound(t *testing.T) {
+	standInPreCheckTerraformCLI(t)
+
+	s := acctest.NewStandIn(t)
+	s.HandleJSON(names.Logs, "CreateLogGroup", acctest.StandInResponse{})
+	// The log group is found when read after creation and then disappears.
+	s.HandleJSON(names.Logs, "DescribeLogGroups",
+		acctest.StandInResponse{Body: standInLogGroups("tf-acc-test-stand-in")},
+		acctest.StandInResponse{Body: standInLogGroups()},
+	)
+	s.HandleJSON(names.Logs, "ListTagsLogGroup", acctest.StandInResponse{Body: map[string]any{"tags": map[string]string{}}})
+	// DeleteLogGroup is not handled, so any attempt to delete the removed log group fails the test.
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if got := len(s.Requests(names.Logs, "DescribeLogGroups")); got < 2 {
+				return fmt.Errorf("got %d DescribeLogGroups requests, expected at least 2", got)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:             acctest.ConfigCompose(s.ProviderConfig(), testAccStandInConfig_logGroup),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -12,12 +12,15 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
@@ -171,90 +174,43 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
+		redactor, err := vcrRedactorFromEnv()
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
+		// Number recorded requests so that identical requests, e.g. from polling waiters, replay in order.
+		recorded := newVCRSequencer()
+		r.AddHook(func(i *cassette.Interaction) error {
+			n := recorded.next(vcrRequestFingerprint(i.Request.Method, redactor.redact(i.Request.URL), i.Request.Headers.Get("Content-Type"), redactor.redact(i.Request.Body)))
 
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+			// The request headers are shared with the live request.
+			i.Request.Headers = i.Request.Headers.Clone()
+			i.Request.Headers.Set(vcrSequenceHeader, strconv.Itoa(n))
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			return nil
+		}, recorder.AfterCaptureHook)
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		// Remove sensitive values before saving.
+		// Live requests and responses are not modified.
+		r.AddHook(func(i *cassette.Interaction) error {
+			redactor.redactInteraction(i)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			return nil
+		}, recorder.BeforeSaveHook)
 
-			return false
-		})
+		// Defines how VCR will match requests to responses.
+		r.SetMatcher(vcrRequestMatcher(ctx, redactor))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient.Transport = &vcrSequencingTransport{
+			recorder:  r,
+			redactor:  redactor,
+			sequencer: newVCRSequencer(),
+		}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -286,6 +242,204 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrRequestMatcher returns a function that matches live requests to recorded interactions.
+// Sensitive values are redacted from live requests before matching.
+func vcrRequestMatcher(ctx context.Context, redactor *vcrRedactor) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || redactor.redact(r.URL.String()) != redactor.redact(i.URL) {
+			return false
+		}
+
+		// Interactions recorded without a sequence number match any identical request.
+		if v := i.Headers.Get(vcrSequenceHeader); v != "" {
+			if n, ok := r.Context().Value(vcrSequenceContextKey{}).(int); ok && strconv.Itoa(n) != v {
+				return false
+			}
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redactor.redact(b.String())
+		cassetteBody := redactor.redact(i.Body)
+		// If body matches identically, we are done.
+		if body == cassetteBody {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch mediaType := vcrMediaType(r.Header.Get("Content-Type")); mediaType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(cassetteBody), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+
+		case "application/x-www-form-urlencoded":
+			// Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+			requestQuery, err := url.ParseQuery(body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request query", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteQuery, err := url.ParseQuery(cassetteBody)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette query", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestQuery, cassetteQuery)
+		}
+
+		return false
+	}
+}
+
+// vcrMediaType returns the media type of a Content-Type header value, without any parameters.
+func vcrMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return contentType
+	}
+
+	return mediaType
+}
+
+// vcrRequestFingerprint returns a value identifying equivalent requests.
+// Reordered JSON and query protocol bodies have the same fingerprint.
+func vcrRequestFingerprint(method, requestURL, contentType, body string) string {
+	switch vcrMediaType(contentType) {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v interface{}
+		if err := json.Unmarshal([]byte(body), &v); err == nil {
+			// Map keys are marshaled in sorted order.
+			if b, err := json.Marshal(v); err == nil {
+				body = string(b)
+			}
+		}
+
+	case "application/x-www-form-urlencoded":
+		if v, err := url.ParseQuery(body); err == nil {
+			// Encode sorts by key.
+			body = v.Encode()
+		}
+	}
+
+	return strings.Join([]string{method, requestURL, body}, "\n")
+}
+
+const (
+	// vcrSequenceHeader is the recorded request header containing the request's sequence number.
+	// The Nth identical request replays the Nth recorded interaction.
+	vcrSequenceHeader = "X-Vcr-Sequence"
+)
+
+// vcrSequencer counts requests by fingerprint.
+type vcrSequencer struct {
+	counts map[string]int
+	lock   sync.Mutex
+}
+
+func newVCRSequencer() *vcrSequencer {
+	return &vcrSequencer{
+		counts: make(map[string]int),
+	}
+}
+
+// next returns the 1-based sequence number of the next request with the specified fingerprint.
+func (s *vcrSequencer) next(fingerprint string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.counts[fingerprint]++
+
+	return s.counts[fingerprint]
+}
+
+type vcrSequenceContextKey struct{}
+
+// vcrSequencingTransport is an http.RoundTripper that places each request's sequence number into its Context
+// before passing it to the VCR recorder.
+type vcrSequencingTransport struct {
+	recorder  *recorder.Recorder
+	redactor  *vcrRedactor
+	sequencer *vcrSequencer
+}
+
+func (t *vcrSequencingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body string
+	if r.Body != nil && r.Body != http.NoBody {
+		b, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.Body.Close()
+		body = string(b)
+	}
+
+	n := t.sequencer.next(vcrRequestFingerprint(r.Method, t.redactor.redact(r.URL.String()), r.Header.Get("Content-Type"), t.redactor.redact(body)))
+	r = r.WithContext(context.WithValue(r.Context(), vcrSequenceContextKey{}, n))
+	if r.Body != nil && r.Body != http.NoBody {
+		r.Body = io.NopCloser(strings.NewReader(body))
+	}
+
+	return t.recorder.RoundTrip(r)
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -390,9 +544,9 @@ func closeVCRRecorder(t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient().Transport.(*recorder.Recorder); ok {
+			if v, ok := meta.HTTPClient().Transport.(*vcrSequencingTransport); ok {
 				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
+				if err := v.recorder.Stop(); err != nil {
 					t.Error(err)
 				}
 			}
@@ -419,13 +573,27 @@ func closeVCRRecorder(t *testing.T) {
 }
 
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// Once the test is running in parallel it waits until the resources in its configuration are within the concurrency limits.
 func ParallelTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		resource.ParallelTest(t, c)
+		return
+	}
+
+	t.Parallel()
+
+	// Slots must only be acquired once the test is running in parallel, as the tests holding them may still be paused.
+	release := acquireConcurrencySlots(t, c)
+	defer release()
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(t)
 	}
 
-	resource.ParallelTest(t, c)
+	resource.Test(t, c)
 }
 
 // Test wraps resource.Test, initializing VCR if enabled.
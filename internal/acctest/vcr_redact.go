@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// envVarVCRRedactFields is a comma-separated list of additional request and response body fields whose values are redacted from cassettes.
+	envVarVCRRedactFields = "VCR_REDACT_FIELDS"
+	// envVarVCRRedactExpressions is a whitespace-separated list of regular expressions whose matches are redacted from cassettes.
+	envVarVCRRedactExpressions = "VCR_REDACT_EXPRESSIONS"
+)
+
+const (
+	vcrRedacted  = "REDACTED"
+	vcrAccountID = "123456789012"
+)
+
+var (
+	// vcrRedactedHeaders are the HTTP headers removed from cassettes.
+	vcrRedactedHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrAccountIDFields are the request and response body fields whose AWS account ID values are replaced in cassettes.
+	vcrAccountIDFields = []string{
+		"Account",
+		"AccountId",
+		"OwnerId",
+	}
+)
+
+type vcrRedactionRule struct {
+	expression  *regexp.Regexp
+	replacement string
+}
+
+// vcrRedactor redacts sensitive values from VCR cassette interactions.
+// The same redactions are made to live requests before matching them to recorded interactions.
+type vcrRedactor struct {
+	fields []string
+	rules  []vcrRedactionRule
+}
+
+// newVCRRedactor returns a redactor for the default fields, presigned URL parameters and AWS account IDs
+// plus the specified additional fields and regular expressions.
+func newVCRRedactor(fields, expressions []string) (*vcrRedactor, error) {
+	fields = append(slices.Clone(logging.DefaultRedactedFields), fields...)
+	fields = slices.DeleteFunc(fields, func(v string) bool {
+		return v == ""
+	})
+
+	redactor := &vcrRedactor{
+		fields: fields,
+	}
+
+	for _, field := range fields {
+		redactor.rules = append(redactor.rules, vcrFieldRedactionRules(field, `(?:[^"\\]|\\.)*`, `[^<]*`, `[^&]*`, vcrRedacted)...)
+	}
+	for _, field := range vcrAccountIDFields {
+		redactor.rules = append(redactor.rules, vcrFieldRedactionRules(field, `\d{12}`, `\d{12}`, `\d{12}`, vcrAccountID)...)
+	}
+
+	redactor.rules = append(redactor.rules,
+		// Presigned URL credentials and signatures.
+		vcrRedactionRule{
+			expression:  regexache.MustCompile(`(?i)([?&]X-Amz-(?:Credential|Security-Token|Signature)=)[^&"<\s\\]*`),
+			replacement: "${1}" + vcrRedacted,
+		},
+		// AWS account IDs in ARNs, including URL-encoded ARNs.
+		vcrRedactionRule{
+			expression:  regexache.MustCompile(`(arn:aws[\w-]*:[\w-]*:[\w-]*:)\d{12}`),
+			replacement: "${1}" + vcrAccountID,
+		},
+		vcrRedactionRule{
+			expression:  regexache.MustCompile(`(?i)(arn%3Aaws[\w-]*%3A[\w-]*%3A[\w-]*%3A)\d{12}`),
+			replacement: "${1}" + vcrAccountID,
+		},
+		// AWS account IDs in S3 Control endpoint hostnames.
+		vcrRedactionRule{
+			expression:  regexache.MustCompile(`(?i)(https?://)\d{12}(\.s3-control)`),
+			replacement: "${1}" + vcrAccountID + "${2}",
+		},
+	)
+
+	for _, v := range expressions {
+		expression, err := regexp.Compile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid VCR redaction expression (%s): %w", v, err)
+		}
+
+		redactor.rules = append(redactor.rules, vcrRedactionRule{
+			expression:  expression,
+			replacement: vcrRedacted,
+		})
+	}
+
+	return redactor, nil
+}
+
+// vcrRedactorFromEnv returns a redactor configured from environment variables.
+func vcrRedactorFromEnv() (*vcrRedactor, error) {
+	var fields []string
+	if v := os.Getenv(envVarVCRRedactFields); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			fields = append(fields, strings.TrimSpace(field))
+		}
+	}
+
+	return newVCRRedactor(fields, strings.Fields(os.Getenv(envVarVCRRedactExpressions)))
+}
+
+// vcrFieldRedactionRules returns rules replacing the value of a field in JSON, XML and query protocol bodies.
+// Field names are matched case-insensitively, as some protocols use camel case.
+func vcrFieldRedactionRules(field, jsonValue, xmlValue, queryValue, replacement string) []vcrRedactionRule {
+	field = regexp.QuoteMeta(field)
+
+	return []vcrRedactionRule{
+		{
+			expression:  regexache.MustCompile(fmt.Sprintf(`(?i)("%[1]s"\s*:\s*")%[2]s(")`, field, jsonValue)),
+			replacement: "${1}" + replacement + "${2}",
+		},
+		{
+			expression:  regexache.MustCompile(fmt.Sprintf(`(?i)(<%[1]s>)%[2]s(</%[1]s>)`, field, xmlValue)),
+			replacement: "${1}" + replacement + "${2}",
+		},
+		{
+			expression:  regexache.MustCompile(fmt.Sprintf(`(?i)((?:^|[?&.])%[1]s=)%[2]s`, field, queryValue)),
+			replacement: "${1}" + replacement,
+		},
+	}
+}
+
+// redact returns the specified string with all sensitive values redacted.
+func (r *vcrRedactor) redact(s string) string {
+	for _, rule := range r.rules {
+		s = rule.expression.ReplaceAllString(s, rule.replacement)
+	}
+
+	return s
+}
+
+// redactForm redacts the values of sensitive query protocol parameters, e.g. "LaunchTemplateData.UserData".
+func (r *vcrRedactor) redactForm(form url.Values) {
+	for k, vs := range form {
+		name := k
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+
+		isRedactedField := slices.ContainsFunc(r.fields, func(field string) bool {
+			return strings.EqualFold(field, name)
+		})
+
+		for i, v := range vs {
+			if isRedactedField {
+				vs[i] = vcrRedacted
+			} else {
+				vs[i] = r.redact(v)
+			}
+		}
+	}
+}
+
+// redactHeaders removes sensitive HTTP headers and redacts the values of the remainder.
+func (r *vcrRedactor) redactHeaders(headers http.Header) {
+	for _, k := range vcrRedactedHeaders {
+		headers.Del(k)
+	}
+
+	for _, vs := range headers {
+		for i, v := range vs {
+			vs[i] = r.redact(v)
+		}
+	}
+}
+
+// redactInteraction redacts a captured VCR interaction before it is saved to a cassette.
+func (r *vcrRedactor) redactInteraction(i *cassette.Interaction) {
+	r.redactHeaders(i.Request.Headers)
+	r.redactForm(i.Request.Form)
+	i.Request.Body = r.redact(i.Request.Body)
+	i.Request.URL = r.redact(i.Request.URL)
+
+	r.redactHeaders(i.Response.Headers)
+	i.Response.Body = r.redact(i.Response.Body)
+	if i.Response.ContentLength >= 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.FormatInt(i.Response.ContentLength, 10))
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+const (
+	// apiLoggingSubsystem is the name of the tflog subsystem to which AWS API call attempts are logged.
+	// Its level can be set independently using the TF_LOG_PROVIDER_AWS_API environment variable.
+	apiLoggingSubsystem = "aws-api"
+)
+
+// newAPILoggingSubsystem adds the AWS API call logging subsystem and redaction of secret-bearing
+// AWS API request and response body fields to Context.
+// Redaction applies to the HTTP request and response logging of both AWS SDK for Go v1 and v2.
+func newAPILoggingSubsystem(ctx context.Context, redactedFields []string) context.Context {
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, logging.RedactionExpressions(redactedFields...)...)
+	ctx = tflog.NewSubsystem(ctx, apiLoggingSubsystem, tflog.WithRootFields(), tflog.WithLevelFromEnv("TF_LOG_PROVIDER_AWS", "API"))
+
+	return ctx
+}
+
+// logAPICallAttempt logs a single AWS API call attempt.
+func logAPICallAttempt(ctx context.Context, service, operation, region, requestID string, attempt int, latency time.Duration, err error) {
+	fields := map[string]any{
+		logging.KeyAPIAttempt:   attempt,
+		logging.KeyAPILatency:   latency.Milliseconds(),
+		logging.KeyAPIOperation: operation,
+		logging.KeyAPIRegion:    region,
+		logging.KeyAPIService:   service,
+	}
+	if requestID != "" {
+		fields[logging.KeyAPIRequestID] = requestID
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, apiLoggingSubsystem, "AWS API call attempt failed", fields)
+	} else {
+		tflog.SubsystemDebug(ctx, apiLoggingSubsystem, "AWS API call attempt", fields)
+	}
+}
+
+// apiLoggingMiddleware is AWS SDK for Go v2 middleware that logs each AWS API call attempt.
+type apiLoggingMiddleware struct{}
+
+func (apiLoggingMiddleware) ID() string {
+	return "TF_AWS_APILogging"
+}
+
+func (apiLoggingMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	// The attempt number is set in a request header by the retry middleware.
+	attempt := 1
+	if v, ok := in.Request.(*smithyhttp.Request); ok {
+		if n, ok := parseAttemptHeader(v.Header.Get("Amz-Sdk-Request")); ok {
+			attempt = n
+		}
+	}
+
+	start := time.Now()
+	out, metadata, err := next.HandleFinalize(ctx, in)
+	latency := time.Since(start)
+
+	requestID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
+	logAPICallAttempt(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), awsmiddleware.GetRegion(ctx), requestID, attempt, latency, err)
+
+	return out, metadata, err
+}
+
+// addAPILoggingMiddleware adds apiLoggingMiddleware to the end of the stack's Finalize step,
+// after the retry middleware, so that it runs once per attempt.
+func addAPILoggingMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(apiLoggingMiddleware{}, middleware.After)
+}
+
+// parseAttemptHeader returns the attempt number from an amz-sdk-request header value, e.g. "attempt=1; max=3".
+func parseAttemptHeader(v string) (int, bool) {
+	if m := regexache.MustCompile(`attempt=(\d+)`).FindStringSubmatch(v); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// apiLoggingHandler returns an AWS SDK for Go v1 request handler that logs each AWS API call attempt.
+// It must be added to the CompleteAttempt handlers.
+func apiLoggingHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tf.APILoggingHandler",
+		Fn: func(r *request.Request) {
+			logAPICallAttempt(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, aws.StringValue(r.Config.Region), r.RequestID, r.RetryCount+1, time.Since(r.AttemptTime), r.Error)
+		},
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+func TestAPILoggingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	ctx = newAPILoggingSubsystem(ctx, nil)
+	ctx = awsmiddleware.SetServiceID(ctx, "Secrets Manager")
+
+	request := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+	request.Header.Set("Amz-Sdk-Request", "attempt=2; max=3")
+
+	next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		var metadata middleware.Metadata
+		awsmiddleware.SetRequestIDMetadata(&metadata, "a1b2c3d4")
+		return middleware.FinalizeOutput{}, metadata, nil
+	})
+
+	if _, _, err := (apiLoggingMiddleware{}).HandleFinalize(ctx, middleware.FinalizeInput{Request: request}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if err != nil {
+		t.Fatalf("decoding log entries: %s", err)
+	}
+
+	if got, expected := len(entries), 1; got != expected {
+		t.Fatalf("got %d log entries, expected %d", got, expected)
+	}
+
+	entry := entries[0]
+	for k, expected := range map[string]any{
+		logging.KeyAPIAttempt:   float64(2),
+		logging.KeyAPIRequestID: "a1b2c3d4",
+		logging.KeyAPIService:   "Secrets Manager",
+		"@module":               "provider." + apiLoggingSubsystem,
+	} {
+		if got := entry[k]; got != expected {
+			t.Errorf("%s: got %v, expected %v", k, got, expected)
+		}
+	}
+	if _, ok := entry[logging.KeyAPILatency]; !ok {
+		t.Errorf("%s: not logged", logging.KeyAPILatency)
+	}
+}
+
+func TestAPILoggingRedaction(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	ctx = newAPILoggingSubsystem(ctx, []string{"ApiKey"})
+
+	tflog.Debug(ctx, "HTTP Request Sent", map[string]any{
+		"http.request.body": `{"ApiKey":"k3y","Name":"example","SecretString":"s3cr3t"}`,
+	})
+
+	got := buf.String()
+	for _, v := range []string{"k3y", "s3cr3t"} {
+		if strings.Contains(got, v) {
+			t.Errorf("log output contains %q: %s", v, got)
+		}
+	}
+	if !strings.Contains(got, "example") {
+		t.Errorf("log output does not contain %q: %s", "example", got)
+	}
+}
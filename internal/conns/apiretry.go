@@ -4,8 +4,11 @@
 package conns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"golang.org/x/time/rate"
 )
 
 // AddIsErrorRetryables returns a Retryer which runs the specified retryables on any error.
@@ -27,3 +30,37 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// AddRateLimiter returns a Retryer which waits on the specified rate limiter before each request attempt.
+// A Retryer that does not implement RetryerV2 is wrapped as the AWS SDK for Go v2 does, using GetInitialToken for each attempt.
+func AddRateLimiter(r aws.Retryer, limiter *rate.Limiter) aws.RetryerV2 {
+	v, ok := r.(aws.RetryerV2)
+	if !ok {
+		v = &retryerV2{Retryer: r}
+	}
+
+	return &withRateLimiter{
+		RetryerV2: v,
+		limiter:   limiter,
+	}
+}
+
+type retryerV2 struct {
+	aws.Retryer
+}
+
+func (r *retryerV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.Retryer.GetInitialToken(), nil
+}
+
+type withRateLimiter struct {
+	aws.RetryerV2
+	limiter *rate.Limiter
+}
+
+func (r *withRateLimiter) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.RetryerV2.GetAttemptToken(ctx)
+}
@@ -4,12 +4,15 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"golang.org/x/time/rate"
 )
 
 func TestAddIsErrorRetryables(t *testing.T) {
@@ -52,3 +55,67 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	retryer := AddRateLimiter(retry.NewStandard(), limiter)
+
+	// The first attempt consumes the only token.
+	release, err := retryer.GetAttemptToken(ctx)
+	if err != nil {
+		t.Fatalf("GetAttemptToken: unexpected error: %s", err)
+	}
+	if err := release(nil); err != nil {
+		t.Fatalf("release: unexpected error: %s", err)
+	}
+
+	// The second attempt must wait for a token.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := retryer.GetAttemptToken(ctx); err == nil {
+		t.Fatal("GetAttemptToken: expected error, got none")
+	}
+
+	// The rate limiter is preserved when further retryables are added.
+	retryer = AddIsErrorRetryables(retryer)
+	if _, err := retryer.GetAttemptToken(ctx); err == nil {
+		t.Fatal("GetAttemptToken: expected error, got none")
+	}
+}
+
+type retryerV1 struct {
+	aws.Retryer
+	initialTokens int
+}
+
+func (r *retryerV1) GetInitialToken() func(error) error {
+	r.initialTokens++
+	return r.Retryer.GetInitialToken()
+}
+
+func TestAddRateLimiterRetryerV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	v1 := &retryerV1{Retryer: retry.NewStandard()}
+	retryer := AddRateLimiter(v1, limiter)
+
+	if _, err := retryer.GetAttemptToken(ctx); err != nil {
+		t.Fatalf("GetAttemptToken: unexpected error: %s", err)
+	}
+	if got, want := v1.initialTokens, 1; got != want {
+		t.Errorf("GetInitialToken calls = %d, want %d", got, want)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := retryer.GetAttemptToken(ctx); err == nil {
+		t.Fatal("GetAttemptToken: expected error, got none")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleChain returns the configured IAM Roles to assume, in order, skipping any without a role ARN.
+func (c *Config) assumeRoleChain() []*awsbase.AssumeRole {
+	var chain []*awsbase.AssumeRole
+
+	for _, v := range c.AssumeRole {
+		if v != nil && v.RoleARN != "" {
+			chain = append(chain, v)
+		}
+	}
+
+	return chain
+}
+
+// chainAssumeRoles assumes the second and subsequent IAM Roles in chain in turn,
+// each using the credentials of the role before it.
+// cfg's credentials must be those of the first role in chain.
+func (c *Config) chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config, chain []*awsbase.AssumeRole) (aws_sdkv2.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for i := 1; i < len(chain); i++ {
+		ar, hop, total := chain[i], i+1, len(chain)
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             hop,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.BaseEndpoint = aws_sdkv2.String(v)
+			}
+		})
+
+		provider := stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return cfg, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  assumeRoleHopSummary("Cannot assume IAM Role", hop, total),
+				Detail:   fmt.Sprintf("IAM Role (%s) cannot be assumed using the credentials from the previous assume_role.\n\nError: %s", ar.RoleARN, err),
+			})
+		}
+
+		cfg = cfg.Copy()
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return cfg, diags
+}
+
+// assumeRoleHopSummary qualifies the summary of a diagnostic for the hop'th IAM Role in a chain of total roles.
+func assumeRoleHopSummary(summary string, hop, total int) string {
+	return fmt.Sprintf("%s (assume_role %d of %d)", summary, hop, total)
+}
+
+func expandAssumeRoleOptions(o *stscreds_sdkv2.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.RoleSessionName = ar.SessionName
+	o.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = ar.TransitiveTagKeys
+}
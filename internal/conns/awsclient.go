@@ -11,7 +11,9 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -21,6 +23,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/exp/maps"
+	"golang.org/x/time/rate"
 )
 
 type AWSClient struct {
@@ -34,6 +37,7 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicy               *tftags.TagPolicy
 	TerraformVersion        string
 
 	awsConfig                 *aws_sdkv2.Config
@@ -43,6 +47,10 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	logRedactedFields         []string         // From provider configuration.
+	rateLimitConfig           *RateLimitConfig // From provider configuration.
+	rateLimiters              map[string]*rate.Limiter
+	rateLimitersLock          sync.Mutex
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
@@ -70,9 +78,20 @@ func (c *AWSClient) PartitionHostname(prefix string) string {
 
 // RegionalHostname returns a hostname with the provider domain suffix for the region and partition
 // e.g. PREFIX.us-west-2.amazonaws.com
+// The Region is the one used for API calls made in the specified Context.
 // The prefix should not contain a trailing period.
-func (c *AWSClient) RegionalHostname(prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix)
+func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
+	return fmt.Sprintf("%s.%s.%s", prefix, c.RegionForContext(ctx), c.DNSSuffix)
+}
+
+// RegionForContext returns the AWS Region used for API calls made in the specified Context.
+// This is the per-resource Region override, if any, otherwise the provider's configured Region.
+func (c *AWSClient) RegionForContext(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return c.Region
 }
 
 // S3ExpressClient returns an S3 API client suitable for use with S3 Express (directory buckets).
@@ -81,6 +100,17 @@ func (c *AWSClient) RegionalHostname(prefix string) string {
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	s3Client := c.S3Client(ctx)
 
+	// Only the client for the provider's configured Region is cached.
+	if c.RegionForContext(ctx) != c.Region {
+		if s3Client.Options().Region == names.GlobalRegionID {
+			return errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -116,29 +146,33 @@ func (c *AWSClient) HTTPClient() *http.Client {
 }
 
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
+// AWS API call logging is also configured.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
-	return baselogging.RegisterLogger(ctx, c.logger)
+	ctx = baselogging.RegisterLogger(ctx, c.logger)
+	ctx = newAPILoggingSubsystem(ctx, c.logRedactedFields)
+
+	return ctx
 }
 
-// APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
+// APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the AWS Region used for API calls made in the specified Context.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
-func (c *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
-	return fmt.Sprintf("https://%s/%s", c.RegionalHostname(fmt.Sprintf("%s.execute-api", restAPIID)), stageName)
+func (c *AWSClient) APIGatewayInvokeURL(ctx context.Context, restAPIID, stageName string) string {
+	return fmt.Sprintf("https://%s/%s", c.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", restAPIID)), stageName)
 }
 
-// APIGatewayV2InvokeURL returns the Amazon API Gateway v2 (WebSocket & HTTP APIs) invoke URL for the configured AWS Region.
+// APIGatewayV2InvokeURL returns the Amazon API Gateway v2 (WebSocket & HTTP APIs) invoke URL for the AWS Region used for API calls made in the specified Context.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-publish.html and
 // https://docs.aws.amazon.com/apigateway/latest/developerguide/apigateway-set-up-websocket-deployment.html.
-func (c *AWSClient) APIGatewayV2InvokeURL(protocolType, apiID, stageName string) string {
+func (c *AWSClient) APIGatewayV2InvokeURL(ctx context.Context, protocolType, apiID, stageName string) string {
 	if protocolType == apigatewayv2_sdkv1.ProtocolTypeWebsocket {
-		return fmt.Sprintf("wss://%s/%s", c.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)), stageName)
+		return fmt.Sprintf("wss://%s/%s", c.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)), stageName)
 	}
 
 	if stageName == "$default" {
-		return fmt.Sprintf("https://%s/", c.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)))
+		return fmt.Sprintf("https://%s/", c.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)))
 	}
 
-	return fmt.Sprintf("https://%s/%s", c.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)), stageName)
+	return fmt.Sprintf("https://%s/%s", c.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)), stageName)
 }
 
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
@@ -178,12 +212,46 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID() string {
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (c *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+// The AWS SDK configuration is adjusted for any per-resource Region override in Context
+// and for any API request rate limit.
+func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, session := c.awsConfig, c.Session
+	if region := c.RegionForContext(ctx); region != c.Region {
+		if awsConfig != nil {
+			cfg := awsConfig.Copy()
+			cfg.Region = region
+			awsConfig = &cfg
+		}
+		if session != nil {
+			session = session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+		}
+	}
+	if limiter := c.rateLimiter(servicePackageName); limiter != nil {
+		if awsConfig != nil {
+			cfg := awsConfig.Copy()
+			newRetryer := cfg.Retryer
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				var r aws_sdkv2.Retryer
+				if newRetryer != nil {
+					r = newRetryer()
+				} else {
+					r = retry_sdkv2.NewStandard()
+				}
+				return AddRateLimiter(r, limiter)
+			}
+			awsConfig = &cfg
+		}
+		if session != nil {
+			session = session.Copy()
+			session.Handlers.Sign.PushFrontNamed(rateLimitHandler(limiter))
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition,
-		"session":          c.Session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -201,16 +269,22 @@ func (c *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
 	return m
 }
 
+// clientCacheKey returns the key used to cache the default API client for the specified service and Region.
+func clientCacheKey(servicePackageName, region string) string {
+	return servicePackageName + "@" + region
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) for each Region is cached. In this case the AWSClient lock is held.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
-	// Default service client is cached.
+	// Default service client for each Region is cached.
+	key := clientCacheKey(servicePackageName, c.RegionForContext(ctx))
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -234,7 +308,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	conn, err := v.NewConn(ctx, config)
 	if err != nil {
@@ -254,22 +328,23 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) for each Region is cached. In this case the AWSClient lock is held.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
-	// Default service client is cached.
+	// Default service client for each Region is cached.
+	key := clientCacheKey(servicePackageName, c.RegionForContext(ctx))
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -293,7 +368,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
@@ -304,7 +379,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"context"
 	"testing"
 )
 
@@ -52,10 +53,11 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	t.Parallel()
 
 	testCases := []struct {
-		Name      string
-		AWSClient *AWSClient
-		Prefix    string
-		Expected  string
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Prefix         string
+		Expected       string
 	}{
 		{
 			Name: "AWS Commercial",
@@ -75,6 +77,16 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
 		},
+		{
+			Name: "Region override",
+			AWSClient: &AWSClient{
+				DNSSuffix: "amazonaws.com",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-central-1", //lintignore:AWSAT003
+			Prefix:         "test",
+			Expected:       "test.eu-central-1.amazonaws.com", //lintignore:AWSAT003
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -82,7 +94,62 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.AWSClient.RegionalHostname(testCase.Prefix)
+			ctx := NewResourceContext(context.Background(), "efs", "File System")
+			if v, ok := FromContext(ctx); ok {
+				v.OverrideRegion = testCase.OverrideRegion
+			}
+			got := testCase.AWSClient.RegionalHostname(ctx, testCase.Prefix)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionForContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Context        func(context.Context) context.Context
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name:     "no resource context",
+			Context:  func(ctx context.Context) context.Context { return ctx },
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "no override",
+			Context: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "ec2", "VPC")
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "ec2", "VPC")
+				if v, ok := FromContext(ctx); ok {
+					v.OverrideRegion = "eu-central-1" //lintignore:AWSAT003
+				}
+				return ctx
+			},
+			Expected: "eu-central-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			}
+			got := client.RegionForContext(testCase.Context(context.Background()))
 
 			if got != testCase.Expected {
 				t.Errorf("got %s, expected %s", got, testCase.Expected)
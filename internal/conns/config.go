@@ -27,7 +27,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -40,8 +40,10 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LogRedactedFields              []string
 	MaxRetries                     int
 	NoProxy                        string
+	RateLimit                      *RateLimitConfig
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
@@ -55,6 +57,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicy                      *tftags.TagPolicy
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -99,8 +102,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first IAM Role in any chain is assumed by aws-sdk-go-base, the remainder by chainAssumeRoles.
+	assumeRoleChain := c.assumeRoleChain()
+	if len(assumeRoleChain) > 0 {
+		awsbaseConfig.AssumeRole = assumeRoleChain[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -133,9 +138,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		severity, summary := baseSeverityToSdkSeverity(d.Severity()), d.Summary()
+		// aws-sdk-go-base assumes the first IAM Role in a chain.
+		if severity == diag.Error && summary == "Cannot assume IAM Role" && len(assumeRoleChain) > 1 {
+			summary = assumeRoleHopSummary(summary, 1, len(assumeRoleChain))
+		}
 		diags = append(diags, diag.Diagnostic{
-			Severity: baseSeverityToSdkSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Severity: severity,
+			Summary:  summary,
 			Detail:   d.Detail(),
 		})
 	}
@@ -144,6 +154,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(assumeRoleChain) > 1 {
+		var chainDiags diag.Diagnostics
+		cfg, chainDiags = c.chainAssumeRoles(ctx, cfg, assumeRoleChain)
+		diags = append(diags, chainDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -186,7 +206,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
-		return nil, sdkdiag.AppendErrorf(diags, err.Error())
+		return nil, sdkdiag.AppendFromErr(diags, err)
 	}
 
 	DNSSuffix := "amazonaws.com"
@@ -203,14 +223,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = names.ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.Session.Handlers.CompleteAttempt.PushBackNamed(apiLoggingHandler())
+	client.TagPolicy = c.TagPolicy
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
+	client.awsConfig.APIOptions = append(client.awsConfig.APIOptions, addAPILoggingMiddleware)
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
-	client.endpoints = c.Endpoints
+	client.endpoints = resolveEndpoints(ctx, cfg, c.Endpoints)
 	client.logger = logger
+	client.logRedactedFields = c.LogRedactedFields
+	client.rateLimitConfig = c.RateLimit
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -508,3 +508,131 @@ func TestProxyConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv
 		})
 	}
 }
+
+func TestAssumeRoleChain(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	const (
+		chainedRoleARN         = "arn:aws:iam::555555555555:role/ChainedRole"
+		chainedRoleSessionName = "ChainedRoleSessionName"
+		chainedRoleExternalID  = "ChainedRoleExternalId"
+	)
+
+	cases := map[string]struct {
+		assumeRole       []any
+		expectedErrorSum string
+	}{
+		"single role": {
+			assumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+		},
+
+		"chained roles": {
+			assumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"external_id":  chainedRoleExternalID,
+					"role_arn":     chainedRoleARN,
+					"session_name": chainedRoleSessionName,
+				},
+			},
+		},
+
+		"chained roles second fails": {
+			assumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     chainedRoleARN,
+					"session_name": chainedRoleSessionName,
+				},
+			},
+			expectedErrorSum: "Cannot assume IAM Role (assume_role 2 of 2)",
+		},
+
+		"chained roles first fails": {
+			assumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": "InvalidSessionName",
+				},
+				map[string]any{
+					"external_id":  chainedRoleExternalID,
+					"role_arn":     chainedRoleARN,
+					"session_name": chainedRoleSessionName,
+				},
+			},
+			expectedErrorSum: "Cannot assume IAM Role (assume_role 1 of 2)",
+		},
+	}
+
+	for name, tc := range cases { //nolint:paralleltest // uses t.Setenv
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE"} {
+				t.Setenv(k, "")
+			}
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"ExternalId":      chainedRoleExternalID,
+					"RoleArn":         chainedRoleARN,
+					"RoleSessionName": chainedRoleSessionName,
+				}),
+			})
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"assume_role":                 tc.assumeRole,
+				"endpoints":                   []any{map[string]any{"sts": ts.URL}},
+				"region":                      "us-west-2",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if tc.expectedErrorSum != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error %q, got none", tc.expectedErrorSum)
+				}
+				if got := diags[len(diags)-1].Summary; got != tc.expectedErrorSum {
+					t.Fatalf("expected error %q, got %q", tc.expectedErrorSum, got)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			credentials, err := meta.AwsConfig().Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+
+			if got, want := credentials.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; got != want {
+				t.Errorf("expected access key %q, got %q", want, got)
+			}
+		})
+	}
+}
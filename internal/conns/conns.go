@@ -25,6 +25,20 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
+// Ephemeral resources are only implemented by service packages that have at least one.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithFrameworkListResources is an interface that extends ServicePackage with list resources.
+// List resources are only implemented by service packages that have at least one.
+type ServicePackageWithFrameworkListResources interface {
+	ServicePackage
+	FrameworkListResources(context.Context) []*types.ServicePackageFrameworkListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -35,9 +49,12 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	IsDataSource          bool   // Data source?
+	IsEphemeralResource   bool   // Ephemeral resource?
+	OverrideRegion        string // Per-resource Region override, if any
+	ResourceName          string // Friendly resource name, e.g. "Subnet"
+	ServicePackageName    string // Canonical name defined as a constant in names package
+	TagPolicyResourceType string // AWS Organizations tag policy resource type, e.g. "ec2:instance", if known
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -50,6 +67,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeralResource: true,
+		ResourceName:        resourceName,
+		ServicePackageName:  servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
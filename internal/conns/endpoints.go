@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"os"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envVarBaseEndpoint = "AWS_ENDPOINT_URL"
+)
+
+// serviceBaseEndpointProvider is implemented by AWS SDK for Go v2 configuration sources that provide service-specific endpoints,
+// i.e. `AWS_ENDPOINT_URL_<SERVICE>` environment variables and shared configuration file `services` sections.
+type serviceBaseEndpointProvider interface {
+	GetServiceBaseEndpoint(ctx context.Context, sdkID string) (string, bool, error)
+}
+
+// ignoreConfiguredEndpointsProvider is implemented by AWS SDK for Go v2 configuration sources that can disable configured endpoints.
+type ignoreConfiguredEndpointsProvider interface {
+	GetIgnoreConfiguredEndpoints(ctx context.Context) (bool, bool, error)
+}
+
+// resolveEndpoints returns the custom endpoint, if any, for each service package.
+// Endpoints set in the provider configuration take precedence. Otherwise, the endpoint is resolved in the same way as by
+// AWS SDK for Go v2 API clients, so that AWS SDK for Go v1 API clients also honor the AWS SDK's endpoint configuration:
+//
+//  1. The service's `AWS_ENDPOINT_URL_<SERVICE>` environment variable
+//  2. The `AWS_ENDPOINT_URL` environment variable
+//  3. The service's `endpoint_url` in the shared configuration file profile's `services` section
+//  4. The shared configuration file profile's `endpoint_url`
+func resolveEndpoints(ctx context.Context, cfg aws_sdkv2.Config, configured map[string]string) map[string]string {
+	endpoints := make(map[string]string, len(configured))
+
+	for pkg, endpoint := range configured {
+		endpoints[pkg] = endpoint
+	}
+
+	if ignoreConfiguredEndpoints(ctx, cfg.ConfigSources) {
+		return endpoints
+	}
+
+	for _, pkg := range names.ProviderPackages() {
+		if endpoints[pkg] != "" {
+			continue
+		}
+
+		if endpoint := resolveServiceEndpoint(ctx, cfg, names.AwsServiceEnvVar(pkg), names.SDKID(pkg)); endpoint != "" {
+			tflog.Debug(ctx, "Resolved service endpoint", map[string]any{
+				"tf_aws.service_package": pkg,
+				"tf_aws.endpoint":        endpoint,
+			})
+			endpoints[pkg] = endpoint
+		}
+	}
+
+	return endpoints
+}
+
+func resolveServiceEndpoint(ctx context.Context, cfg aws_sdkv2.Config, envVar, sdkID string) string {
+	if envVar != "" {
+		if v := os.Getenv(envVar); v != "" {
+			return v
+		}
+	}
+
+	// The base endpoint environment variable takes precedence over service endpoints in the shared configuration file.
+	if v := os.Getenv(envVarBaseEndpoint); v != "" {
+		return v
+	}
+
+	if sdkID != "" {
+		for _, source := range cfg.ConfigSources {
+			if p, ok := source.(serviceBaseEndpointProvider); ok {
+				if v, found, err := p.GetServiceBaseEndpoint(ctx, sdkID); err == nil && found {
+					return v
+				}
+			}
+		}
+	}
+
+	return aws_sdkv2.ToString(cfg.BaseEndpoint)
+}
+
+func ignoreConfiguredEndpoints(ctx context.Context, sources []any) bool {
+	for _, source := range sources {
+		if p, ok := source.(ignoreConfiguredEndpointsProvider); ok {
+			if v, found, err := p.GetIgnoreConfiguredEndpoints(ctx); err == nil && found {
+				return v
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResolveEndpoints(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	const (
+		configuredEndpoint    = "https://configured.example.com"
+		envVarEndpoint        = "https://envvar.example.com"
+		baseEnvVarEndpoint    = "https://base-envvar.example.com"
+		serviceConfigEndpoint = "https://service-config.example.com"
+		baseConfigEndpoint    = "https://base-config.example.com"
+	)
+
+	testCases := map[string]struct {
+		configured  map[string]string
+		envVars     map[string]string
+		configFile  string
+		expectedSQS string
+		expectedSNS string
+	}{
+		"none": {},
+		"configured": {
+			configured: map[string]string{
+				names.SQS: configuredEndpoint,
+			},
+			envVars: map[string]string{
+				"AWS_ENDPOINT_URL_SQS": envVarEndpoint,
+			},
+			expectedSQS: configuredEndpoint,
+		},
+		"service envvar": {
+			envVars: map[string]string{
+				"AWS_ENDPOINT_URL_SQS": envVarEndpoint,
+				"AWS_ENDPOINT_URL":     baseEnvVarEndpoint,
+			},
+			expectedSQS: envVarEndpoint,
+			expectedSNS: baseEnvVarEndpoint,
+		},
+		"base envvar overrides service config": {
+			envVars: map[string]string{
+				"AWS_ENDPOINT_URL": baseEnvVarEndpoint,
+			},
+			configFile: `
+[default]
+services = test
+
+[services test]
+sqs =
+  endpoint_url = ` + serviceConfigEndpoint + `
+`,
+			expectedSQS: baseEnvVarEndpoint,
+			expectedSNS: baseEnvVarEndpoint,
+		},
+		"service config": {
+			configFile: `
+[default]
+endpoint_url = ` + baseConfigEndpoint + `
+services = test
+
+[services test]
+sqs =
+  endpoint_url = ` + serviceConfigEndpoint + `
+`,
+			expectedSQS: serviceConfigEndpoint,
+			expectedSNS: baseConfigEndpoint,
+		},
+		"ignore configured endpoints": {
+			configured: map[string]string{
+				names.SQS: configuredEndpoint,
+			},
+			envVars: map[string]string{
+				"AWS_ENDPOINT_URL_SNS":                envVarEndpoint,
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+			},
+			expectedSQS: configuredEndpoint,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// Isolate the test from the environment.
+			for _, pkg := range []string{names.SNS, names.SQS} {
+				t.Setenv(names.AwsServiceEnvVar(pkg), "")
+			}
+			t.Setenv("AWS_ENDPOINT_URL", "")
+			t.Setenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS", "")
+			t.Setenv("AWS_PROFILE", "")
+
+			configFile := filepath.Join(t.TempDir(), "config")
+			if err := os.WriteFile(configFile, []byte(testCase.configFile), 0600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("AWS_CONFIG_FILE", configFile)
+			t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+
+			for k, v := range testCase.envVars {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				t.Fatalf("loading configuration: %s", err)
+			}
+
+			endpoints := resolveEndpoints(ctx, cfg, testCase.configured)
+
+			if got, want := endpoints[names.SQS], testCase.expectedSQS; got != want {
+				t.Errorf("SQS endpoint = %q, want %q", got, want)
+			}
+			if got, want := endpoints[names.SNS], testCase.expectedSNS; got != want {
+				t.Errorf("SNS endpoint = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestResolveEndpointsDoesNotModifyConfigured(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv("AWS_ENDPOINT_URL", "https://base-envvar.example.com")
+
+	configured := map[string]string{}
+
+	resolveEndpoints(context.Background(), aws_sdkv2.Config{}, configured)
+
+	if len(configured) != 0 {
+		t.Errorf("configured endpoints modified: %v", configured)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"math"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is a token bucket limit on the rate of AWS API requests.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+func (r RateLimit) newLimiter() *rate.Limiter {
+	burst := r.Burst
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(r.RequestsPerSecond)))
+	}
+
+	return rate.NewLimiter(rate.Limit(r.RequestsPerSecond), burst)
+}
+
+// RateLimitConfig is the provider's AWS API request rate limit configuration.
+// Each service package has its own token bucket, shared by its AWS SDK for Go v1 and v2 API clients.
+type RateLimitConfig struct {
+	Default  *RateLimit           // Applies to each service package not in Services separately; it is not an overall limit.
+	Services map[string]RateLimit // Keyed by service package name.
+}
+
+// forService returns the rate limit for the specified service package, if any.
+func (c *RateLimitConfig) forService(servicePackageName string) (RateLimit, bool) {
+	if c == nil {
+		return RateLimit{}, false
+	}
+
+	if v, ok := c.Services[servicePackageName]; ok {
+		return v, true
+	}
+
+	if c.Default != nil {
+		return *c.Default, true
+	}
+
+	return RateLimit{}, false
+}
+
+// rateLimiter returns the rate limiter for the specified service package, or nil if requests are not rate limited.
+func (c *AWSClient) rateLimiter(servicePackageName string) *rate.Limiter {
+	c.rateLimitersLock.Lock()
+	defer c.rateLimitersLock.Unlock()
+
+	if v, ok := c.rateLimiters[servicePackageName]; ok {
+		return v
+	}
+
+	var limiter *rate.Limiter
+	if v, ok := c.rateLimitConfig.forService(servicePackageName); ok {
+		limiter = v.newLimiter()
+	}
+
+	if c.rateLimiters == nil {
+		c.rateLimiters = make(map[string]*rate.Limiter)
+	}
+	c.rateLimiters[servicePackageName] = limiter
+
+	return limiter
+}
+
+// rateLimitHandler returns an AWS SDK for Go v1 request handler that waits on the specified rate limiter.
+// It must be added to the Sign handlers, which run before each request attempt.
+func rateLimitHandler(limiter *rate.Limiter) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tf.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			if err := limiter.Wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+func TestAWSClientRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		config             *RateLimitConfig
+		servicePackageName string
+		expectedLimit      rate.Limit
+		expectedBurst      int
+		expectedNil        bool
+	}{
+		{
+			name:               "no configuration",
+			servicePackageName: "ec2",
+			expectedNil:        true,
+		},
+		{
+			name:               "no default",
+			config:             &RateLimitConfig{Services: map[string]RateLimit{"iam": {RequestsPerSecond: 1}}},
+			servicePackageName: "ec2",
+			expectedNil:        true,
+		},
+		{
+			name:               "default",
+			config:             &RateLimitConfig{Default: &RateLimit{RequestsPerSecond: 2.5}},
+			servicePackageName: "ec2",
+			expectedLimit:      2.5,
+			expectedBurst:      3,
+		},
+		{
+			name: "service override",
+			config: &RateLimitConfig{
+				Default:  &RateLimit{RequestsPerSecond: 20, Burst: 40},
+				Services: map[string]RateLimit{"route53": {RequestsPerSecond: 0.5}},
+			},
+			servicePackageName: "route53",
+			expectedLimit:      0.5,
+			expectedBurst:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{rateLimitConfig: testCase.config}
+			limiter := client.rateLimiter(testCase.servicePackageName)
+
+			if testCase.expectedNil {
+				if limiter != nil {
+					t.Fatalf("expected no rate limiter, got %v", limiter)
+				}
+				return
+			}
+
+			if limiter == nil {
+				t.Fatal("expected rate limiter, got none")
+			}
+			if got, want := limiter.Limit(), testCase.expectedLimit; got != want {
+				t.Errorf("Limit() = %v, want %v", got, want)
+			}
+			if got, want := limiter.Burst(), testCase.expectedBurst; got != want {
+				t.Errorf("Burst() = %v, want %v", got, want)
+			}
+
+			// The same limiter is shared by all of the service's API clients.
+			if client.rateLimiter(testCase.servicePackageName) != limiter {
+				t.Error("expected the same rate limiter on subsequent calls")
+			}
+		})
+	}
+}
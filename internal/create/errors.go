@@ -22,6 +22,7 @@ const (
 	ErrActionCreating             = "creating"
 	ErrActionDeleting             = "deleting"
 	ErrActionImporting            = "importing"
+	ErrActionListing              = "listing"
 	ErrActionReading              = "reading"
 	ErrActionSetting              = "setting"
 	ErrActionUpdating             = "updating"
@@ -83,7 +84,7 @@ func diagError(service, action, resource, id string, gotError error) diag.Diagno
 
 func AppendDiagErrorMessage(diags diag.Diagnostics, service, action, resource, id, message string) diag.Diagnostics {
 	return append(diags,
-		diagError(service, action, resource, id, fmt.Errorf(message)),
+		diagError(service, action, resource, id, errors.New(message)),
 	)
 }
 
@@ -97,7 +98,7 @@ func AppendDiagWarningMessage(diags diag.Diagnostics, service, action, resource,
 	return append(diags,
 		diag.Diagnostic{
 			Severity: diag.Warning,
-			Summary:  ProblemStandardMessage(service, action, resource, id, fmt.Errorf(message)),
+			Summary:  ProblemStandardMessage(service, action, resource, id, errors.New(message)),
 		},
 	)
 }
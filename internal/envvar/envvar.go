@@ -66,6 +66,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For acceptance tests, a JSON file mapping resource types to the maximum number that tests may use concurrently
+	// Overrides the provider's default limits
+	AccConcurrencyLimitsFile = "TF_ACC_CONCURRENCY_LIMITS_FILE"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +88,33 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for filtering and reporting resource sweepers
+const (
+	// Whether to list the resources that would be swept without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of name prefixes of resources to sweep
+	SweepIncludeNamePrefixes = "TF_AWS_SWEEP_INCLUDE_NAME_PREFIXES"
+
+	// Comma-separated list of name prefixes of resources not to sweep
+	SweepExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+
+	// Comma-separated list of tags, as key=value or key, of resources to sweep
+	SweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Comma-separated list of tags, as key=value or key, of resources not to sweep
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Minimum age of resources to sweep, as a duration such as 24h
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Maximum age of resources to sweep, as a duration such as 168h
+	SweepMaxAge = "TF_AWS_SWEEP_MAX_AGE"
+
+	// Path of the file to write a JSON report of swept resources to
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
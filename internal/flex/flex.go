@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -396,3 +398,29 @@ func DiffStringMaps(oldMap, newMap map[string]interface{}) (map[string]*string,
 
 	return add, remove, unchanged
 }
+
+// GetWriteOnlyStringValue returns the configured value of the write-only string attribute at the specified path.
+// Write-only values are never persisted in state so must be read from the raw configuration during Create or Update.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d *schema.ResourceData, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !v.Type().Equals(cty.String) {
+		return "", append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid write-only attribute type",
+			Detail:        fmt.Sprintf("expected %s, got %s", cty.String.FriendlyName(), v.Type().FriendlyName()),
+			AttributePath: path,
+		})
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
@@ -5,11 +5,13 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -29,11 +33,12 @@ func (w *withMeta) Meta() *conns.AWSClient {
 }
 
 // RegionalARN returns a regional ARN for the specified service namespace and resource.
-func (w *withMeta) RegionalARN(service, resource string) string {
+// The ARN is in the Region used for API calls made in the specified Context.
+func (w *withMeta) RegionalARN(ctx context.Context, service, resource string) string {
 	return arn.ARN{
 		Partition: w.meta.Partition,
 		Service:   service,
-		Region:    w.meta.Region,
+		Region:    w.meta.RegionForContext(ctx),
 		AccountID: w.meta.AccountID,
 		Resource:  resource,
 	}.String()
@@ -88,6 +93,12 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
+
+			if err := verify.CheckTagPolicy(ctx, r.Meta().TagPolicy, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy", err.Error())
+				return
+			}
+
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -107,6 +118,79 @@ func (w *WithImportByID) ImportState(ctx context.Context, request resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
 }
 
+// WithImportByIdentity is intended to be embedded in resources which import state via the "id" attribute
+// or via the resource's identity (Terraform v1.12 and later).
+// The resource's identity attributes are declared using @IdentityAttribute annotations.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/identity.
+type WithImportByIdentity struct {
+	identity itypes.ServicePackageResourceIdentity
+}
+
+// SetIdentitySpec sets the resource's identity specification.
+func (w *WithImportByIdentity) SetIdentitySpec(identity itypes.ServicePackageResourceIdentity) {
+	w.identity = identity
+}
+
+// IdentitySpec returns the resource's identity specification.
+func (w *WithImportByIdentity) IdentitySpec() itypes.ServicePackageResourceIdentity {
+	return w.identity
+}
+
+func (w *WithImportByIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+		return
+	}
+
+	if request.Identity == nil {
+		response.Diagnostics.AddError(
+			"Missing Import Identity",
+			"Either an import ID or an identity must be specified.",
+		)
+
+		return
+	}
+
+	// Copy each identity attribute to the state attribute of the same name.
+	for _, attr := range w.identity.Attributes {
+		identityPath := path.Root(attr.Name)
+
+		// Use a Go type so that the value can be set in state attributes of custom string types (e.g. ARNs).
+		var v *string
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, identityPath, &v)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if v == nil {
+			if attr.Required {
+				response.Diagnostics.AddAttributeError(
+					identityPath,
+					"Missing Required Identity Attribute",
+					fmt.Sprintf("The identity attribute %q is required when importing by identity.", attr.Name),
+				)
+
+				return
+			}
+
+			continue
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, identityPath, v)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if attr.Name == w.identity.IDAttrShadowsAttr {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), v)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
+
 // DataSourceWithConfigure is a structure to be embedded within a DataSource that implements the DataSourceWithConfigure interface.
 type DataSourceWithConfigure struct {
 	withMeta
@@ -122,6 +206,25 @@ func (d *DataSourceWithConfigure) Configure(_ context.Context, request datasourc
 	}
 }
 
+// EphemeralResourceWithConfigure is a structure to be embedded within an EphemeralResource that implements the EphemeralResourceWithConfigure interface.
+type EphemeralResourceWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined EphemeralResource type.
+func (r *EphemeralResourceWithConfigure) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+}
+
+// WithRegionModel is intended to be embedded in the models of resources and data sources
+// which support per-resource Region override, i.e. have the "region" attribute injected into their schema.
+type WithRegionModel struct {
+	Region types.String `tfsdk:"region" autoflex:"-"`
+}
+
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
 // See https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts.
 type WithTimeouts struct {
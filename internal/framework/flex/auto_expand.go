@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
@@ -25,10 +25,8 @@ import (
 // target data type) are copied.
 func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	expander := &autoExpander{}
-
-	for _, optFn := range optFns {
-		optFn(expander)
+	expander := &autoExpander{
+		options: newAutoFlexOptions(optFns),
 	}
 
 	diags.Append(autoFlexConvert(ctx, tfObject, apiObject, expander)...)
@@ -40,7 +38,17 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	options AutoFlexOptions
+}
+
+func (expander autoExpander) getOptions() AutoFlexOptions {
+	return expander.options
+}
+
+func (autoExpander) isExpander() bool {
+	return true
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -89,10 +97,10 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -126,10 +134,10 @@ func (expander autoExpander) bool(ctx context.Context, vFrom basetypes.BoolValua
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -171,10 +179,10 @@ func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float6
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -198,28 +206,23 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 		return diags
 
 	case reflect.Ptr:
-		switch vTo.Type().Elem().Kind() {
-		case reflect.Int32:
-			//
-			// types.Int32/types.Int64 -> *int32.
-			//
-			to := int32(v.ValueInt64())
-			vTo.Set(reflect.ValueOf(&to))
-			return diags
-
-		case reflect.Int64:
+		switch tElem := vTo.Type().Elem(); tElem.Kind() {
+		case reflect.Int32, reflect.Int64:
 			//
-			// types.Int32/types.Int64 -> *int64.
+			// types.Int32/types.Int64 -> *int32/*int64.
 			//
-			vTo.Set(reflect.ValueOf(v.ValueInt64Pointer()))
+			// The target may be a named (enum-like) integer type.
+			to := reflect.New(tElem)
+			to.Elem().SetInt(v.ValueInt64())
+			vTo.Set(to)
 			return diags
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -249,13 +252,58 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			vTo.Set(reflect.ValueOf(t.ValueTimestamp()))
 			return diags
 		}
-	case reflect.Ptr:
+
+		//
+		// types.String (RFC 3339) --> time.Time
+		//
+		if vTo.Type() == reflect.TypeFor[time.Time]() {
+			t, err := time.Parse(time.RFC3339, v.ValueString())
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("parsing timestamp: %s", err))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(t))
+			return diags
+		}
+
+	case reflect.Slice:
 		switch vTo.Type().Elem().Kind() {
+		case reflect.Uint8:
+			//
+			// types.String -> []byte.
+			//
+			vTo.SetBytes([]byte(v.ValueString()))
+			return diags
+		}
+
+	case reflect.Interface:
+		//
+		// fwtypes.SmithyJSON -> smithy document.
+		//
+		if vFrom, ok := vFrom.(fwtypes.SmithyDocumentValue); ok {
+			doc, d := vFrom.ToSmithyDocument(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if vDoc := reflect.ValueOf(doc); vDoc.IsValid() && vDoc.Type().AssignableTo(vTo.Type()) {
+				vTo.Set(vDoc)
+				return diags
+			}
+		}
+
+	case reflect.Ptr:
+		switch tElem := vTo.Type().Elem(); tElem.Kind() {
 		case reflect.String:
 			//
 			// types.String -> *string.
 			//
-			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
+			// The target may be a named (enum-like) string type.
+			to := reflect.New(tElem)
+			to.Elem().SetString(v.ValueString())
+			vTo.Set(to)
 			return diags
 		case reflect.Struct:
 			//
@@ -265,13 +313,27 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 				vTo.Set(reflect.ValueOf(t.ValueTimestampPointer()))
 				return diags
 			}
+
+			//
+			// types.String (RFC 3339) --> *time.Time
+			//
+			if tElem == reflect.TypeFor[time.Time]() {
+				t, err := time.Parse(time.RFC3339, v.ValueString())
+				if err != nil {
+					diags.AddError("AutoFlEx", fmt.Sprintf("parsing timestamp: %s", err))
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(&t))
+				return diags
+			}
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -298,10 +360,10 @@ func (expander autoExpander) list(ctx context.Context, vFrom basetypes.ListValua
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from list[%s]": v.ElementType(ctx),
 		"to":            vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -344,10 +406,10 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from list[%s]": vFrom.ElementType(ctx),
 		"to":            vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -373,10 +435,10 @@ func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuab
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from map[string, %s]": v.ElementType(ctx),
 		"to":                   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -422,10 +484,10 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from map[string, %s]": vFrom.ElementType(ctx),
 		"to":                   vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -452,10 +514,10 @@ func (expander autoExpander) set(ctx context.Context, vFrom basetypes.SetValuabl
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from set[%s]": v.ElementType(ctx),
 		"to":           vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -498,10 +560,10 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from set[%s]": vFrom.ElementType(ctx),
 		"to":           vTo.Kind(),
-	})
+	})...)
 
 	return diags
 }
@@ -541,6 +603,13 @@ func (expander autoExpander) nestedObject(ctx context.Context, vFrom fwtypes.Nes
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// types.List(OfObject) -> tagged union.
+		//
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+		return diags
+
 	case reflect.Slice:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -594,6 +663,62 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwt
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API tagged union value.
+// The nested Object must implement TaggedUnion and at most one of its fields may be set.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	union, ok := from.(TaggedUnion)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("%T does not implement TaggedUnion, cannot be expanded to %s", from, tUnion))
+		return diags
+	}
+
+	var member reflect.Value
+	valFrom := reflect.ValueOf(from).Elem()
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if member.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("more than one member of %T is set", from))
+			return diags
+		}
+
+		tMember := unionMemberType(union, field.Name)
+		if tMember == nil || !reflect.PointerTo(tMember).Implements(tUnion) {
+			diags.AddError("AutoFlEx", fmt.Sprintf("%s has no member named %s", tUnion, field.Name))
+			return diags
+		}
+
+		// Create a new union member and convert its value.
+		member = reflect.New(tMember)
+		diags.Append(expander.convert(ctx, valFrom.Field(i), member.Elem().FieldByName(unionMemberValueFieldName))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
 // nestedObjectToSlice copies a Plugin Framework NestedObjectValue to a compatible AWS API [](*)struct value.
 func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwtypes.NestedObjectValue, tSlice, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
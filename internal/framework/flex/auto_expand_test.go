@@ -30,9 +30,11 @@ func TestExpand(t *testing.T) {
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
 
+	testIntEnum := TestFlexIntEnum(2)
+
 	testCases := []struct {
-		Context    context.Context //nolint:containedctx // testing context use
 		TestName   string
+		Options    []AutoFlexOptionsFunc
 		Source     any
 		Target     any
 		WantErr    bool
@@ -253,8 +255,8 @@ func TestExpand(t *testing.T) {
 			},
 		},
 		{
-			Context:  context.WithValue(ctx, ResourcePrefix, "Intent"),
 			TestName: "resource name prefix",
+			Options:  []AutoFlexOptionsFunc{WithFieldNamePrefix("Intent")},
 			Source: &TestFlexTF16{
 				Name: types.StringValue("Ovodoghen"),
 			},
@@ -263,6 +265,70 @@ func TestExpand(t *testing.T) {
 				IntentName: aws.String("Ovodoghen"),
 			},
 		},
+		{
+			TestName: "ignored field names",
+			Options:  []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field2")},
+			Source: &TestFlexOptionsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &TestFlexOptionsAWS01{},
+			WantTarget: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+			},
+		},
+		{
+			TestName: "field name mapping",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Field2", "Field3")},
+			Source: &TestFlexOptionsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &TestFlexOptionsAWS01{},
+			WantTarget: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+				Field3: aws.String("b"),
+			},
+		},
+		{
+			TestName: "field name mapping not found",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Field2", "Field4")},
+			Source: &TestFlexOptionsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target:  &TestFlexOptionsAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "struct tags",
+			Source: &TestFlexOptionsTF02{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+				Field3: types.StringValue("c"),
+			},
+			Target: &TestFlexOptionsAWS01{},
+			WantTarget: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+				Field3: aws.String("b"),
+			},
+		},
+		{
+			TestName: "struct tag not found",
+			Source: &TestFlexOptionsTF03{
+				Field1: types.StringValue("a"),
+			},
+			Target:  &TestFlexOptionsAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "struct tag incompatible types",
+			Source: &TestFlexOptionsTF04{
+				Field1: types.BoolValue(true),
+			},
+			Target:  &TestFlexOptionsAWS01{},
+			WantErr: true,
+		},
 		{
 			TestName:   "single ARN Source and single string Target",
 			Source:     &TestFlexTF17{Field1: fwtypes.ARNValue(testARN)},
@@ -295,6 +361,99 @@ func TestExpand(t *testing.T) {
 				CreationDateTime: testTimeTime,
 			},
 		},
+		{
+			TestName: "enum-like int, blob and RFC 3339 strings",
+			Source: &TestFlexTF19{
+				Field1: types.Int64Value(2),
+				Field2: types.StringValue("blob"),
+				Field3: types.StringValue(testTimeStr),
+				Field4: types.StringValue(testTimeStr),
+			},
+			Target: &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{
+				Field1: &testIntEnum,
+				Field2: []byte("blob"),
+				Field3: &testTimeTime,
+				Field4: testTimeTime,
+			},
+		},
+		{
+			TestName: "invalid RFC 3339 string",
+			Source: &TestFlexTF19{
+				Field3: types.StringValue("yesterday"),
+			},
+			Target:  &TestFlexAWS19{},
+			WantErr: true,
+		},
+		{
+			TestName: "smithy document",
+			Source: &TestFlexDocumentTF01{
+				Document: fwtypes.SmithyJSONValue(`{"field1": "a", "field2": [1, 2]}`, newTestFlexLazyDocument),
+			},
+			Target: &TestFlexDocumentAWS01{},
+			WantTarget: &TestFlexDocumentAWS01{
+				Document: &TestFlexLazyDocument{Value: map[string]any{"field1": "a", "field2": []any{float64(1), float64(2)}}},
+			},
+		},
+		{
+			TestName: "null smithy document",
+			Source: &TestFlexDocumentTF01{
+				Document: fwtypes.SmithyJSONNull[TestFlexDocument](),
+			},
+			Target:     &TestFlexDocumentAWS01{},
+			WantTarget: &TestFlexDocumentAWS01{},
+		},
+		{
+			TestName: "tagged union string member",
+			Source: &TestFlexUnionTF02{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{
+					Name:   types.StringValue("a"),
+					Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+			Target: &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{
+				Definition: &TestFlexUnionAWSMemberName{Value: "a"},
+			},
+		},
+		{
+			TestName: "tagged union struct member",
+			Source: &TestFlexUnionTF02{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{
+					Name: types.StringNull(),
+					Static: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			Target: &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{
+				Definition: &TestFlexUnionAWSMemberStatic{Value: TestFlexAWS01{Field1: "b"}},
+			},
+		},
+		{
+			TestName: "tagged union multiple members",
+			Source: &TestFlexUnionTF02{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{
+					Name: types.StringValue("a"),
+					Static: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "tagged union not implemented",
+			Source: &TestFlexUnionTF03{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{
+					Field1: types.StringValue("a"),
+				}),
+			},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -302,12 +461,7 @@ func TestExpand(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			testCtx := ctx //nolint:contextcheck // simplify use of testing context
-			if testCase.Context != nil {
-				testCtx = testCase.Context
-			}
-
-			err := Expand(testCtx, testCase.Source, testCase.Target)
+			err := Expand(ctx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
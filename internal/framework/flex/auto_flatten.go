@@ -9,11 +9,12 @@ import (
 	"reflect"
 	"time"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
@@ -27,10 +28,8 @@ import (
 // suitable target data type) are copied.
 func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	flattener := &autoFlattener{}
-
-	for _, optFn := range optFns {
-		optFn(flattener)
+	flattener := &autoFlattener{
+		options: newAutoFlexOptions(optFns),
 	}
 
 	diags.Append(autoFlexConvert(ctx, apiObject, tfObject, flattener)...)
@@ -42,7 +41,17 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	return diags
 }
 
-type autoFlattener struct{}
+type autoFlattener struct {
+	options AutoFlexOptions
+}
+
+func (flattener autoFlattener) getOptions() AutoFlexOptions {
+	return flattener.options
+}
+
+func (autoFlattener) isExpander() bool {
+	return false
+}
 
 // convert converts a single AWS API value to its Plugin Framework equivalent.
 func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
@@ -87,12 +96,16 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 	case reflect.Struct:
 		diags.Append(flattener.struct_(ctx, vFrom, false, tTo, vTo)...)
 		return diags
+
+	case reflect.Interface:
+		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -120,10 +133,10 @@ func (flattener autoFlattener) bool(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -151,10 +164,10 @@ func (flattener autoFlattener) float(ctx context.Context, vFrom reflect.Value, i
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -182,10 +195,10 @@ func (flattener autoFlattener) int(ctx context.Context, vFrom reflect.Value, isN
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -213,10 +226,10 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -251,10 +264,10 @@ func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   vTo,
-	})
+	})...)
 
 	return diags
 }
@@ -285,10 +298,10 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 		return diags
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -317,6 +330,152 @@ func (flattener autoFlattener) struct_(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
+	if tTo, ok := tTo.(basetypes.StringTypable); ok && (isNilFrom || vFrom.Type() == reflect.TypeFor[time.Time]()) {
+		//
+		// time.Time -> types.String (RFC 3339).
+		//
+		stringValue := types.StringNull()
+		if !isNilFrom {
+			stringValue = types.StringValue(vFrom.Interface().(time.Time).Format(time.RFC3339))
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
+	return diags
+}
+
+// interface_ copies an AWS API interface value to a compatible Plugin Framework value.
+// Smithy documents are flattened to JSON strings and tagged unions to nested Objects.
+func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	isNilFrom := vFrom.IsNil()
+
+	switch tTo := tTo.(type) {
+	case basetypes.StringTypable:
+		//
+		// smithy document -> fwtypes.SmithyJSON.
+		//
+		stringValue := types.StringNull()
+		if !isNilFrom {
+			doc, ok := vFrom.Interface().(smithydocument.Marshaler)
+			if !ok {
+				break
+			}
+
+			b, err := doc.MarshalSmithyDocument()
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("marshaling smithy document: %s", err))
+				return diags
+			}
+			stringValue = types.StringValue(string(b))
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+
+	case fwtypes.NestedObjectType:
+		//
+		// tagged union -> types.List(OfObject).
+		//
+		diags.Append(flattener.unionToNestedObject(ctx, vFrom, isNilFrom, tTo, vTo)...)
+		return diags
+	}
+
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Type(),
+		"to":   tTo,
+	})...)
+
+	return diags
+}
+
+// unionToNestedObject copies an AWS API tagged union value to a compatible Plugin Framework NestedObjectValue value.
+// The union member's value is copied to the nested Object's field with the same name as the member.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNullFrom {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+
+	memberName := ""
+	if vMember.Kind() == reflect.Struct {
+		memberName = unionMemberName(vMember.Type())
+	}
+	vValue := reflect.Value{}
+	if memberName != "" {
+		vValue = vMember.FieldByName(unionMemberValueFieldName)
+	}
+	if !vValue.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("unsupported tagged union member: %s", vFrom.Elem().Type()))
+		return diags
+	}
+
+	// Create a new target structure and set the member's field.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vField := reflect.ValueOf(to).Elem().FieldByName(memberName)
+	if !vField.IsValid() || !vField.CanSet() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("%T has no field for tagged union member %s", to, memberName))
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, vValue, vField)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Other fields are null.
+	valTo := reflect.ValueOf(to).Elem()
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" || field.Name == memberName {
+			continue
+		}
+
+		diags.Append(setNull(ctx, valTo.Field(i))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
 	return diags
 }
 
@@ -325,6 +484,26 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 	var diags diag.Diagnostics
 
 	switch tSliceElem := vFrom.Type().Elem(); tSliceElem.Kind() {
+	case reflect.Uint8:
+		switch tTo := tTo.(type) {
+		case basetypes.StringTypable:
+			//
+			// []byte -> types.String.
+			//
+			stringValue := types.StringNull()
+			if !vFrom.IsNil() {
+				stringValue = types.StringValue(string(vFrom.Bytes()))
+			}
+			v, d := tTo.ValueFromString(ctx, stringValue)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
 	case reflect.String:
 		switch tTo := tTo.(type) {
 		case basetypes.ListTypable:
@@ -491,10 +670,10 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -635,10 +814,10 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 		}
 	}
 
-	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+	diags.Append(incompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
-	})
+	})...)
 
 	return diags
 }
@@ -847,6 +1026,27 @@ func (flattener autoFlattener) sliceOfStructNestedObject(ctx context.Context, vF
 	return diags
 }
 
+// setNull sets a Plugin Framework value to the null value of its type.
+func setNull(ctx context.Context, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	tTo := valTo.Type(ctx)
+	val, err := tTo.ValueFromTerraform(ctx, tftypes.NewValue(tTo.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("creating null value: %s", err))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
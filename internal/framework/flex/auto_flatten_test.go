@@ -31,9 +31,11 @@ func TestFlatten(t *testing.T) {
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
 
+	testIntEnum := TestFlexIntEnum(2)
+
 	testCases := []struct {
-		Context    context.Context //nolint:containedctx // testing context use
 		TestName   string
+		Options    []AutoFlexOptionsFunc
 		Source     any
 		Target     any
 		WantErr    bool
@@ -367,8 +369,8 @@ func TestFlatten(t *testing.T) {
 			},
 		},
 		{
-			Context:  context.WithValue(ctx, ResourcePrefix, "Intent"),
 			TestName: "resource name prefix",
+			Options:  []AutoFlexOptionsFunc{WithFieldNamePrefix("Intent")},
 			Source: &TestFlexAWS18{
 				IntentName: aws.String("Ovodoghen"),
 			},
@@ -377,6 +379,55 @@ func TestFlatten(t *testing.T) {
 				Name: types.StringValue("Ovodoghen"),
 			},
 		},
+		{
+			TestName: "ignored field names",
+			Options:  []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field2")},
+			Source: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+				Field2: aws.String("b"),
+				Field3: aws.String("c"),
+			},
+			Target: &TestFlexOptionsTF01{},
+			WantTarget: &TestFlexOptionsTF01{
+				Field1: types.StringValue("a"),
+			},
+		},
+		{
+			TestName: "field name mapping",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Field2", "Field3")},
+			Source: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+				Field2: aws.String("b"),
+				Field3: aws.String("c"),
+			},
+			Target: &TestFlexOptionsTF01{},
+			WantTarget: &TestFlexOptionsTF01{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("c"),
+			},
+		},
+		{
+			TestName: "field name mapping not found",
+			Options:  []AutoFlexOptionsFunc{WithFieldNameMapping("Field4", "Field3")},
+			Source: &TestFlexOptionsAWS01{
+				Field3: aws.String("c"),
+			},
+			Target:  &TestFlexOptionsTF01{},
+			WantErr: true,
+		},
+		{
+			TestName: "struct tags",
+			Source: &TestFlexOptionsAWS01{
+				Field1: aws.String("a"),
+				Field2: aws.String("b"),
+				Field3: aws.String("c"),
+			},
+			Target: &TestFlexOptionsTF02{},
+			WantTarget: &TestFlexOptionsTF02{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("c"),
+			},
+		},
 		{
 			TestName:   "single string Source and single ARN Target",
 			Source:     &TestFlexAWS01{Field1: testARN},
@@ -431,6 +482,91 @@ func TestFlatten(t *testing.T) {
 				CreationDateTime: fwtypes.TimestampZero(),
 			},
 		},
+		{
+			TestName: "enum-like int, blob and RFC 3339 strings",
+			Source: &TestFlexAWS19{
+				Field1: &testIntEnum,
+				Field2: []byte("blob"),
+				Field3: &testTimeTime,
+				Field4: testTimeTime,
+			},
+			Target: &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{
+				Field1: types.Int64Value(2),
+				Field2: types.StringValue("blob"),
+				Field3: types.StringValue(testTimeStr),
+				Field4: types.StringValue(testTimeStr),
+			},
+		},
+		{
+			TestName: "nil enum-like int, blob and RFC 3339 string",
+			Source:   &TestFlexAWS19{},
+			Target:   &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{
+				Field1: types.Int64Null(),
+				Field2: types.StringNull(),
+				Field3: types.StringNull(),
+				Field4: types.StringValue("0001-01-01T00:00:00Z"),
+			},
+		},
+		{
+			TestName: "smithy document",
+			Source: &TestFlexDocumentAWS01{
+				Document: newTestFlexLazyDocument(map[string]any{"field1": "a", "field2": []any{1, 2}}),
+			},
+			Target: &TestFlexDocumentTF01{},
+			WantTarget: &TestFlexDocumentTF01{
+				Document: fwtypes.SmithyJSONValue(`{"field1":"a","field2":[1,2]}`, newTestFlexLazyDocument),
+			},
+		},
+		{
+			TestName:   "nil smithy document",
+			Source:     &TestFlexDocumentAWS01{},
+			Target:     &TestFlexDocumentTF01{},
+			WantTarget: &TestFlexDocumentTF01{Document: fwtypes.SmithyJSONNull[TestFlexDocument]()},
+		},
+		{
+			TestName: "tagged union string member",
+			Source: &TestFlexUnionAWS01{
+				Definition: &TestFlexUnionAWSMemberName{Value: "a"},
+			},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{
+					Name:   types.StringValue("a"),
+					Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+		},
+		{
+			TestName: "tagged union struct member",
+			Source: &TestFlexUnionAWS01{
+				Definition: &TestFlexUnionAWSMemberStatic{Value: TestFlexAWS01{Field1: "b"}},
+			},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{
+				Definition: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexUnionTF01{
+					Name: types.StringNull(),
+					Static: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+		},
+		{
+			TestName:   "nil tagged union",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Definition: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName: "tagged union unknown member",
+			Source: &TestFlexUnionAWS01{
+				Definition: &TestFlexUnknownUnionMember{Tag: "Dynamic"},
+			},
+			Target:  &TestFlexUnionTF02{},
+			WantErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -438,12 +574,7 @@ func TestFlatten(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			testCtx := ctx //nolint:contextcheck // simplify use of testing context
-			if testCase.Context != nil {
-				testCtx = testCase.Context
-			}
-
-			err := Flatten(testCtx, testCase.Source, testCase.Target)
+			err := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
@@ -11,14 +11,17 @@ import (
 
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-type ResourcePrefixCtxKey string
+const (
+	MapBlockKey = "MapBlockKey"
+)
 
 const (
-	ResourcePrefix        ResourcePrefixCtxKey = "RESOURCE_PREFIX"
-	ResourcePrefixRecurse ResourcePrefixCtxKey = "RESOURCE_PREFIX_RECURSE"
-	MapBlockKey                                = "MapBlockKey"
+	// autoFlexStructTag is the struct tag on a Plugin Framework data structure's field
+	// that sets the name of the corresponding AWS API field, or "-" to ignore the field.
+	autoFlexStructTag = "autoflex"
 )
 
 // Expand  = TF -->  AWS
@@ -27,10 +30,111 @@ const (
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	getOptions() AutoFlexOptions
+	isExpander() bool
+}
+
+// TaggedUnion is implemented by Plugin Framework data structures that correspond to an AWS API tagged union,
+// for example Verified Permissions' types.PolicyDefinition.
+// Each of the data structure's fields corresponds to the union member with the same name,
+// e.g. field `Static` corresponds to types.PolicyDefinitionMemberStatic, and at most one field is set.
+type TaggedUnion interface {
+	// UnionMembers returns a pointer to the zero value of each of the union's member types,
+	// e.g. &types.PolicyDefinitionMemberStatic{}.
+	UnionMembers() []any
+}
+
+const (
+	// unionMemberValueFieldName is the name of an AWS API tagged union member's value field.
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMemberName returns the name of the AWS API tagged union member with the specified type,
+// e.g. "Static" for types.PolicyDefinitionMemberStatic.
+func unionMemberName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.LastIndex(name, "Member"); i > 0 {
+		return name[i+len("Member"):]
+	}
+
+	return ""
+}
+
+// unionMemberType returns the type of the named member of the specified AWS API tagged union.
+func unionMemberType(union TaggedUnion, memberName string) reflect.Type {
+	for _, v := range union.UnionMembers() {
+		t := reflect.TypeOf(v)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		if t.Kind() == reflect.Struct && unionMemberName(t) == memberName {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// AutoFlexOptions stores options for flattening or expanding.
+type AutoFlexOptions struct {
+	// fieldNameMappings maps Plugin Framework field names to AWS API field names.
+	fieldNameMappings map[string]string
+	// fieldNamePrefix is prepended to (or removed from) field names when no other match is found.
+	fieldNamePrefix   string
+	ignoredFieldNames map[string]bool
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
-type AutoFlexOptionsFunc func(autoFlexer)
+type AutoFlexOptionsFunc func(*AutoFlexOptions)
+
+// WithFieldNamePrefix matches fields whose names differ only by the specified prefix,
+// for example "Name" and "IntentName", when no better match is found.
+func WithFieldNamePrefix(prefix string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.fieldNamePrefix = prefix
+	}
+}
+
+// WithIgnoredFieldNames ignores the specified fields, in both the Plugin Framework and AWS API data structures.
+func WithIgnoredFieldNames(fieldNames ...string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.ignoredFieldNames == nil {
+			o.ignoredFieldNames = make(map[string]bool)
+		}
+		for _, v := range fieldNames {
+			o.ignoredFieldNames[v] = true
+		}
+	}
+}
+
+// WithFieldNameMapping maps a Plugin Framework field to a differently named AWS API field.
+// Unlike fuzzy field name matching, an error is returned if a mapped field is not found or cannot be converted.
+func WithFieldNameMapping(tfFieldName, awsFieldName string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.fieldNameMappings == nil {
+			o.fieldNameMappings = make(map[string]string)
+		}
+		o.fieldNameMappings[tfFieldName] = awsFieldName
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) AutoFlexOptions {
+	var opts AutoFlexOptions
+
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	return opts
+}
+
+func (o AutoFlexOptions) isIgnored(fieldName string) bool {
+	return o.ignoredFieldNames[fieldName]
+}
+
+// explicitFieldNameCtxKey is the context key for the name of a field being converted that was explicitly mapped.
+type explicitFieldNameCtxKey struct{}
 
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
@@ -86,6 +190,8 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
+	opts := flexer.getOptions()
+
 	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
 		if field.PkgPath != "" {
@@ -98,16 +204,43 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == MapBlockKey {
 			continue
 		}
+		if opts.isIgnored(fieldName) {
+			continue
+		}
+
+		toFieldName, explicit, ignored := explicitFieldName(field, valTo, opts, flexer.isExpander())
+		if ignored {
+			continue
+		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom)
-		if !toFieldVal.IsValid() {
-			continue // Corresponding field not found in to.
+		if explicit {
+			if !fieldExistsInStruct(toFieldName, valTo) {
+				diags.AddError("AutoFlEx", fmt.Sprintf("field %s is mapped to %s, which is not found in %s", fieldName, toFieldName, valTo.Type()))
+				return diags
+			}
+		} else {
+			toFieldName = findFieldNameFuzzy(fieldName, valTo, valFrom, opts.fieldNamePrefix, false)
+			if toFieldName == "" {
+				continue // Corresponding field not found in to.
+			}
+			if toField, _ := valTo.Type().FieldByName(toFieldName); opts.isIgnored(toFieldName) || isExplicitlyMapped(toField, valFrom, opts, flexer.isExpander()) {
+				continue // Corresponding field is ignored or only mapped explicitly.
+			}
 		}
+		toFieldVal := valTo.FieldByName(toFieldName)
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		fieldCtx := ctx
+		if explicit {
+			fieldCtx = context.WithValue(ctx, explicitFieldNameCtxKey{}, fieldName)
+		} else if ctx.Value(explicitFieldNameCtxKey{}) != nil {
+			// Fields nested within an explicitly mapped field are matched as usual.
+			fieldCtx = context.WithValue(ctx, explicitFieldNameCtxKey{}, nil)
+		}
+
+		diags.Append(flexer.convert(fieldCtx, valFrom.Field(i), toFieldVal)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
@@ -117,10 +250,91 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) reflect.Value {
+// explicitFieldName returns the name of the target field explicitly mapped from the specified source field,
+// either by an option or by a struct tag on the Plugin Framework data structure.
+// It also returns whether the source field is ignored by its struct tag.
+func explicitFieldName(fieldFrom reflect.StructField, valTo reflect.Value, opts AutoFlexOptions, isExpander bool) (string, bool, bool) {
+	if isExpander {
+		// Plugin Framework --> AWS API.
+		if v, ok := fieldFrom.Tag.Lookup(autoFlexStructTag); ok {
+			if v == "-" {
+				return "", false, true
+			}
+			if v != "" {
+				return v, true, false
+			}
+		}
+
+		if v, ok := opts.fieldNameMappings[fieldFrom.Name]; ok {
+			return v, true, false
+		}
+
+		return "", false, false
+	}
+
+	// AWS API --> Plugin Framework.
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		if v := field.Tag.Get(autoFlexStructTag); v == fieldFrom.Name {
+			return field.Name, true, false
+		}
+	}
+
+	for tfFieldName, awsFieldName := range opts.fieldNameMappings {
+		if awsFieldName == fieldFrom.Name {
+			return tfFieldName, true, false
+		}
+	}
+
+	return "", false, false
+}
+
+// isExplicitlyMapped returns whether the specified target field can only be the target of an explicit mapping,
+// either because it is the explicitly mapped target of a source field or, for Plugin Framework fields,
+// because its struct tag sets a different AWS API field name or ignores it.
+func isExplicitlyMapped(fieldTo reflect.StructField, valFrom reflect.Value, opts AutoFlexOptions, isExpander bool) bool {
+	if isExpander {
+		// Plugin Framework --> AWS API.
+		for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+			field := typFrom.Field(i)
+			if field.PkgPath != "" {
+				continue // Skip unexported fields.
+			}
+
+			if v := field.Tag.Get(autoFlexStructTag); v == fieldTo.Name {
+				return true
+			}
+		}
+
+		for _, awsFieldName := range opts.fieldNameMappings {
+			if awsFieldName == fieldTo.Name {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// AWS API --> Plugin Framework.
+	if v := fieldTo.Tag.Get(autoFlexStructTag); v != "" {
+		return true
+	}
+
+	_, ok := opts.fieldNameMappings[fieldTo.Name]
+
+	return ok
+}
+
+// findFieldNameFuzzy returns the name of the field in `valTo` corresponding to the `valFrom` field `fieldNameFrom`,
+// or an empty string if there is none.
+func findFieldNameFuzzy(fieldNameFrom string, valTo, valFrom reflect.Value, prefix string, prefixRecursed bool) string {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+	if fieldExistsInStruct(fieldNameFrom, valTo) {
+		return fieldNameFrom
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -139,39 +353,35 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if fieldNameTo == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			return fieldNameTo
 		}
 	}
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := plural.Plural(fieldNameFrom); fieldExistsInStruct(v, valTo) {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := plural.Singular(fieldNameFrom); fieldExistsInStruct(v, valTo) {
 			return v
 		}
 	}
 
-	// fourth precedence is using resource prefix
-	if v, ok := ctx.Value(ResourcePrefix).(string); ok && v != "" {
-		if ctx.Value(ResourcePrefixRecurse) == nil {
-			// so it will only recurse once
-			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
-			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom)
-			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom)
+	// fourth precedence is using field name prefix
+	if prefix != "" && !prefixRecursed {
+		// so it will only recurse once
+		if strings.HasPrefix(fieldNameFrom, prefix) {
+			return findFieldNameFuzzy(strings.TrimPrefix(fieldNameFrom, prefix), valTo, valFrom, prefix, true)
 		}
+		return findFieldNameFuzzy(prefix+fieldNameFrom, valTo, valFrom, prefix, true)
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise
+	return ""
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
@@ -181,3 +391,18 @@ func fieldExistsInStruct(field string, str reflect.Value) bool {
 
 	return false
 }
+
+// incompatibleTypes reports that a value cannot be converted to the target type.
+// Explicitly mapped fields fail loudly; otherwise the field is skipped and the incompatibility logged.
+func incompatibleTypes(ctx context.Context, msg string, fields map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if fieldName, ok := ctx.Value(explicitFieldNameCtxKey{}).(string); ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("%s (%s): %v", msg, fieldName, fields))
+		return diags
+	}
+
+	tflog.Info(ctx, msg, fields)
+
+	return diags
+}
@@ -4,8 +4,10 @@
 package flex
 
 import (
+	"encoding/json"
 	"time"
 
+	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -331,3 +333,117 @@ type TestFlexMapBlockKeyTF05 struct {
 	Attr1       types.String                 `tfsdk:"attr1"`
 	Attr2       types.String                 `tfsdk:"attr2"`
 }
+
+type TestFlexOptionsTF01 struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+}
+
+type TestFlexOptionsTF02 struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2" autoflex:"Field3"`
+	Field3 types.String `tfsdk:"field3" autoflex:"-"`
+}
+
+type TestFlexOptionsTF03 struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"Field4"`
+}
+
+type TestFlexOptionsTF04 struct {
+	Field1 types.Bool `tfsdk:"field1" autoflex:"Field2"`
+}
+
+type TestFlexOptionsAWS01 struct {
+	Field1 *string
+	Field2 *string
+	Field3 *string
+}
+
+type TestFlexIntEnum int32
+
+type TestFlexTF19 struct {
+	Field1 types.Int64  `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3"`
+	Field4 types.String `tfsdk:"field4"`
+}
+
+type TestFlexAWS19 struct {
+	Field1 *TestFlexIntEnum
+	Field2 []byte
+	Field3 *time.Time
+	Field4 time.Time
+}
+
+// TestFlexDocument is a smithy document, e.g. kendra's document.Interface.
+type TestFlexDocument interface {
+	smithydocument.Marshaler
+}
+
+type TestFlexLazyDocument struct {
+	Value any
+}
+
+func (d *TestFlexLazyDocument) MarshalSmithyDocument() ([]byte, error) {
+	return json.Marshal(d.Value)
+}
+
+func newTestFlexLazyDocument(v any) TestFlexDocument {
+	return &TestFlexLazyDocument{Value: v}
+}
+
+type TestFlexDocumentTF01 struct {
+	Document fwtypes.SmithyJSON[TestFlexDocument] `tfsdk:"document"`
+}
+
+type TestFlexDocumentAWS01 struct {
+	Document TestFlexDocument
+}
+
+// TestFlexUnionAWS is a tagged union, e.g. verifiedpermissions' types.PolicyDefinition.
+type TestFlexUnionAWS interface {
+	isTestFlexUnionAWS()
+}
+
+type TestFlexUnionAWSMemberName struct {
+	Value string
+}
+
+func (*TestFlexUnionAWSMemberName) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberStatic struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionAWSMemberStatic) isTestFlexUnionAWS() {}
+
+type TestFlexUnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*TestFlexUnknownUnionMember) isTestFlexUnionAWS() {}
+
+type TestFlexUnionTF01 struct {
+	Name   types.String                                  `tfsdk:"name"`
+	Static fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"static"`
+}
+
+func (TestFlexUnionTF01) UnionMembers() []any {
+	return []any{
+		&TestFlexUnionAWSMemberName{},
+		&TestFlexUnionAWSMemberStatic{},
+	}
+}
+
+type TestFlexUnionTF02 struct {
+	Definition fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"definition"`
+}
+
+type TestFlexUnionTF03 struct {
+	Definition fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"definition"`
+}
+
+type TestFlexUnionAWS01 struct {
+	Definition TestFlexUnionAWS
+}
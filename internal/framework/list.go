@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithConfigure is a structure to be embedded within a ListResource that implements the ListResourceWithConfigure interface.
+type ListResourceWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (r *ListResourceWithConfigure) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+}
+
+// WithSDKv2ResourceList is intended to be embedded in list resources which list instances of a Plugin SDK v2 managed resource.
+// The managed resource must support resource identity.
+type WithSDKv2ResourceList struct {
+	resource *schema.Resource
+	identity itypes.ServicePackageResourceIdentity
+}
+
+// SetSDKv2Resource sets the (registered) managed resource whose instances are listed.
+func (w *WithSDKv2ResourceList) SetSDKv2Resource(r *schema.Resource) {
+	w.resource = r
+}
+
+// SetIdentitySpec sets the managed resource's identity specification.
+func (w *WithSDKv2ResourceList) SetIdentitySpec(identity itypes.ServicePackageResourceIdentity) {
+	w.identity = identity
+}
+
+// RawV5Schemas returns the managed resource's schema and identity schema.
+func (w *WithSDKv2ResourceList) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = w.resource.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = w.resource.ProtoIdentitySchema(ctx)()
+}
+
+// ResourceData returns an empty ResourceData for the managed resource.
+func (w *WithSDKv2ResourceList) ResourceData() *schema.ResourceData {
+	return w.resource.Data(&terraform.InstanceState{})
+}
+
+// SetResult populates a list result from the specified ResourceData.
+// The ResourceData's ID and any identity attributes must be set.
+// If includeResource is true the managed resource's Read handler is called to populate the resource's full state.
+// Returns false if the resource no longer exists.
+func (w *WithSDKv2ResourceList) SetResult(ctx context.Context, meta *conns.AWSClient, includeResource bool, d *schema.ResourceData, result *list.ListResult) bool {
+	region := meta.RegionForContext(ctx)
+
+	if includeResource {
+		// The managed resource's Read handler establishes its own Context, so any per-resource Region override is passed via the `region` attribute.
+		if inContext, ok := conns.FromContext(ctx); ok && inContext.OverrideRegion != "" {
+			if err := d.Set(names.AttrRegion, inContext.OverrideRegion); err != nil {
+				result.Diagnostics.AddError(fmt.Sprintf("setting %s", names.AttrRegion), err.Error())
+				return true
+			}
+		}
+
+		result.Diagnostics.Append(fromSDKDiagnostics(w.resource.ReadWithoutTimeout(ctx, d, meta))...)
+		if result.Diagnostics.HasError() {
+			return true
+		}
+
+		// Deleted since being listed.
+		if d.Id() == "" {
+			return false
+		}
+
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("converting resource state", err.Error())
+			return true
+		}
+
+		result.Resource.Raw = *state
+	}
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID)...)
+	if result.Diagnostics.HasError() {
+		return true
+	}
+
+	if !w.identity.IsGlobalResource {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		if result.Diagnostics.HasError() {
+			return true
+		}
+	}
+
+	for _, v := range w.identity.Attributes {
+		var value string
+		switch v.Name {
+		case names.AttrAccountID, names.AttrRegion:
+			// Handled above.
+			continue
+		case names.AttrID:
+			value = d.Id()
+		default:
+			value, _ = d.Get(v.Name).(string)
+		}
+
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(v.Name), value)...)
+		if result.Diagnostics.HasError() {
+			return true
+		}
+	}
+
+	return true
+}
+
+// fromSDKDiagnostics converts Plugin SDK diagnostics to Plugin Framework diagnostics.
+func fromSDKDiagnostics(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range sdkDiags {
+		switch v.Severity {
+		case sdkdiag.Error:
+			diags.AddError(v.Summary, v.Detail)
+		case sdkdiag.Warning:
+			diags.AddWarning(v.Summary, v.Detail)
+		}
+	}
+
+	return diags
+}
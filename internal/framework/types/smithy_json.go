@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SmithyJSONType is a JSON string type that corresponds to an AWS API smithy document type, e.g. kendra's document.Interface.
+// The function f creates a document from a decoded JSON value, e.g. document.NewLazyDocument.
+type SmithyJSONType[T smithydocument.Marshaler] struct {
+	basetypes.StringType
+	f func(any) T
+}
+
+var (
+	_ xattr.TypeWithValidate  = (*SmithyJSONType[smithydocument.Marshaler])(nil)
+	_ basetypes.StringTypable = (*SmithyJSONType[smithydocument.Marshaler])(nil)
+)
+
+func NewSmithyJSONType[T smithydocument.Marshaler](_ context.Context, f func(any) T) SmithyJSONType[T] {
+	return SmithyJSONType[T]{
+		f: f,
+	}
+}
+
+func (t SmithyJSONType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(SmithyJSONType[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SmithyJSONType[T]) String() string {
+	var zero T
+	return fmt.Sprintf("SmithyJSONType[%T]", zero)
+}
+
+func (t SmithyJSONType[T]) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return SmithyJSONNull[T](), diags
+	}
+	if in.IsUnknown() {
+		return SmithyJSONUnknown[T](), diags
+	}
+
+	return SmithyJSON[T]{StringValue: in, f: t.f}, diags
+}
+
+func (t SmithyJSONType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t SmithyJSONType[T]) ValueType(context.Context) attr.Value {
+	return SmithyJSON[T]{f: t.f}
+}
+
+func (t SmithyJSONType[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Given Value: "+value+"\n",
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// SmithyDocumentValue is implemented by values that can be converted to an AWS API smithy document.
+// It isn't generic on the document type as it's referenced within AutoFlEx.
+type SmithyDocumentValue interface {
+	attr.Value
+
+	// ToSmithyDocument returns the value as a smithy document.
+	ToSmithyDocument(context.Context) (smithydocument.Marshaler, diag.Diagnostics)
+}
+
+var (
+	_ basetypes.StringValuable                   = (*SmithyJSON[smithydocument.Marshaler])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SmithyJSON[smithydocument.Marshaler])(nil)
+	_ SmithyDocumentValue                        = (*SmithyJSON[smithydocument.Marshaler])(nil)
+)
+
+func SmithyJSONNull[T smithydocument.Marshaler]() SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringNull()}
+}
+
+func SmithyJSONUnknown[T smithydocument.Marshaler]() SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringUnknown()}
+}
+
+func SmithyJSONValue[T smithydocument.Marshaler](value string, f func(any) T) SmithyJSON[T] {
+	return SmithyJSON[T]{StringValue: basetypes.NewStringValue(value), f: f}
+}
+
+type SmithyJSON[T smithydocument.Marshaler] struct {
+	basetypes.StringValue
+	f func(any) T
+}
+
+func (v SmithyJSON[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SmithyJSON[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SmithyJSON[T]) Type(context.Context) attr.Type {
+	return SmithyJSONType[T]{f: v.f}
+}
+
+// StringSemanticEquals returns whether the two values are equivalent JSON, ignoring whitespace and object key order.
+func (v SmithyJSON[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SmithyJSON[T])
+
+	if !ok {
+		return false, diags
+	}
+
+	var old, new any
+	if err := json.Unmarshal([]byte(v.ValueString()), &old); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &new); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(old, new), diags
+}
+
+// ValueInterface returns the value as a smithy document.
+func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var zero T
+
+	if v.IsNull() || v.IsUnknown() {
+		return zero, diags
+	}
+
+	if v.f == nil {
+		diags.AddError("SmithyJSON", "no document constructor")
+		return zero, diags
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(v.ValueString()), &value); err != nil {
+		diags.AddError("SmithyJSON ValueInterface", err.Error())
+		return zero, diags
+	}
+
+	return v.f(value), diags
+}
+
+func (v SmithyJSON[T]) ToSmithyDocument(context.Context) (smithydocument.Marshaler, diag.Diagnostics) {
+	return v.ValueInterface()
+}
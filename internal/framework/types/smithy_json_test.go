@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/kendra/document"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestSmithyJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid string": {
+			val: tftypes.NewValue(tftypes.String, `{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument).Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestSmithyJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.SmithyJSON[document.Interface]
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			val1:   fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, document.NewLazyDocument),
+			val2:   fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, document.NewLazyDocument),
+			equals: true,
+		},
+		"whitespace and key order": {
+			val1:   fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, document.NewLazyDocument),
+			val2:   fwtypes.SmithyJSONValue(`{"Key2":[1,2,3],"Key1":"Value"}`, document.NewLazyDocument),
+			equals: true,
+		},
+		"not equal": {
+			val1: fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, document.NewLazyDocument),
+			val2: fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [3, 2, 1]}`, document.NewLazyDocument),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
+
+func TestSmithyJSONValueInterface(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.SmithyJSON[document.Interface]
+		expected string
+	}
+	tests := map[string]testCase{
+		"null value": {
+			val: fwtypes.SmithyJSONNull[document.Interface](),
+		},
+		"valid value": {
+			val:      fwtypes.SmithyJSONValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`, document.NewLazyDocument),
+			expected: `{"Key1":"Value","Key2":[1,2,3]}`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, diags := test.val.ValueInterface()
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			var got string
+			if doc != nil {
+				b, err := doc.MarshalSmithyDocument()
+				if err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+				got = string(b)
+			}
+
+			// Object keys are marshaled in map order, so compare the JSON semantically.
+			if test.expected == "" {
+				if got != "" {
+					t.Errorf("got %s, expected null", got)
+				}
+			} else if !verify.JSONStringsEqual(got, test.expected) {
+				t.Errorf("got %s, expected %s", got, test.expected)
+			}
+		})
+	}
+}
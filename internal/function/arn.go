@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validateARN validates that a string value is a well-formed ARN.
+// The same rules as the `verify.ValidARN` schema validator are applied.
+func validateARN(s string) error {
+	_, errs := verify.ValidARN(s, "arn")
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition     string
+		service       string
+		region        string
+		accountID     string
+		resource      string
+		expected      string
+		expectedError bool
+	}{
+		"aws": {
+			partition: "aws",
+			service:   "iam",
+			accountID: "444455556666",
+			resource:  "role/example",
+			expected:  "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+		},
+		"aws-cn": {
+			partition: "aws-cn",
+			service:   "s3",
+			region:    "cn-north-1", // lintignore:AWSAT003
+			accountID: "444455556666",
+			resource:  "accesspoint/example",
+			expected:  "arn:aws-cn:s3:cn-north-1:444455556666:accesspoint/example", // lintignore:AWSAT003,AWSAT005
+		},
+		"aws-us-gov": {
+			partition: "aws-us-gov",
+			service:   "rds",
+			region:    "us-gov-west-1", // lintignore:AWSAT003
+			accountID: "444455556666",
+			resource:  "db:example",
+			expected:  "arn:aws-us-gov:rds:us-gov-west-1:444455556666:db:example", // lintignore:AWSAT003,AWSAT005
+		},
+		"aws-iso": {
+			partition: "aws-iso",
+			service:   "ec2",
+			region:    "us-iso-east-1", // lintignore:AWSAT003
+			accountID: "444455556666",
+			resource:  "vpc/vpc-0123456789abcdef0",
+			expected:  "arn:aws-iso:ec2:us-iso-east-1:444455556666:vpc/vpc-0123456789abcdef0", // lintignore:AWSAT003,AWSAT005
+		},
+		"aws-iso-b": {
+			partition: "aws-iso-b",
+			service:   "sns",
+			region:    "us-isob-east-1", // lintignore:AWSAT003
+			accountID: "444455556666",
+			resource:  "example",
+			expected:  "arn:aws-iso-b:sns:us-isob-east-1:444455556666:example", // lintignore:AWSAT003,AWSAT005
+		},
+		"missing partition": {
+			service:       "iam",
+			accountID:     "444455556666",
+			resource:      "role/example",
+			expectedError: true,
+		},
+		"invalid region": {
+			partition:     "aws",
+			service:       "ec2",
+			region:        "not-a-region",
+			accountID:     "444455556666",
+			resource:      "vpc/vpc-0123456789abcdef0",
+			expectedError: true,
+		},
+		"missing resource": {
+			partition:     "aws",
+			service:       "iam",
+			accountID:     "444455556666",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			f := tffunction.NewARNBuildFunction()
+
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.partition),
+					types.StringValue(testCase.service),
+					types.StringValue(testCase.region),
+					types.StringValue(testCase.accountID),
+					types.StringValue(testCase.resource),
+				}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			f.Run(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Run error = %t, want %t: %v", got, want, response.Error)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if got, want := response.Result.Value(), types.StringValue(testCase.expected); !got.Equal(want) {
+				t.Errorf("Run = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"partition":  types.StringType,
+		"service":    types.StringType,
+		"region":     types.StringType,
+		"account_id": types.StringType,
+		"resource":   types.StringType,
+	}
+	objectValue := func(partition, service, region, accountID, resource string) attr.Value {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"partition":  types.StringValue(partition),
+			"service":    types.StringValue(service),
+			"region":     types.StringValue(region),
+			"account_id": types.StringValue(accountID),
+			"resource":   types.StringValue(resource),
+		})
+	}
+
+	testCases := map[string]struct {
+		arn           string
+		expected      attr.Value
+		expectedError bool
+	}{
+		"aws": {
+			arn:      "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+			expected: objectValue("aws", "iam", "", "444455556666", "role/example"),
+		},
+		"aws-cn": {
+			arn:      "arn:aws-cn:s3:cn-north-1:444455556666:accesspoint/example", // lintignore:AWSAT003,AWSAT005
+			expected: objectValue("aws-cn", "s3", "cn-north-1", "444455556666", "accesspoint/example"),
+		},
+		"aws-us-gov": {
+			arn:      "arn:aws-us-gov:rds:us-gov-west-1:444455556666:db:example", // lintignore:AWSAT003,AWSAT005
+			expected: objectValue("aws-us-gov", "rds", "us-gov-west-1", "444455556666", "db:example"),
+		},
+		"aws-iso": {
+			arn:      "arn:aws-iso:ec2:us-iso-east-1:444455556666:vpc/vpc-0123456789abcdef0", // lintignore:AWSAT003,AWSAT005
+			expected: objectValue("aws-iso", "ec2", "us-iso-east-1", "444455556666", "vpc/vpc-0123456789abcdef0"),
+		},
+		"aws-iso-b": {
+			arn:      "arn:aws-iso-b:sns:us-isob-east-1:444455556666:example", // lintignore:AWSAT003,AWSAT005
+			expected: objectValue("aws-iso-b", "sns", "us-isob-east-1", "444455556666", "example"),
+		},
+		"no account ID": {
+			arn:      "arn:aws:s3:::example-bucket", // lintignore:AWSAT005
+			expected: objectValue("aws", "s3", "", "", "example-bucket"),
+		},
+		"not an ARN": {
+			arn:           "example",
+			expectedError: true,
+		},
+		"invalid partition": {
+			arn:           "arn:gcp:iam::444455556666:role/example", // lintignore:AWSAT005
+			expectedError: true,
+		},
+		"invalid region": {
+			arn:           "arn:aws:ec2:not-a-region:444455556666:vpc/vpc-0123456789abcdef0", // lintignore:AWSAT003,AWSAT005
+			expectedError: true,
+		},
+		"invalid account ID": {
+			arn:           "arn:aws:iam::4444:role/example", // lintignore:AWSAT005
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			f := tffunction.NewARNParseFunction()
+
+			definitionResponse := function.DefinitionResponse{}
+			f.Definition(ctx, function.DefinitionRequest{}, &definitionResponse)
+			result, err := definitionResponse.Definition.Return.NewResultData(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.arn)}),
+			}
+			response := function.RunResponse{
+				Result: result,
+			}
+			f.Run(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Run(%q) error = %t, want %t: %v", testCase.arn, got, want, response.Error)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(response.Result.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = trimIAMRolePathFunction{}
+
+// NewTrimIAMRolePathFunction returns a provider-defined function that removes the path from an IAM role ARN.
+func NewTrimIAMRolePathFunction() function.Function {
+	return &trimIAMRolePathFunction{}
+}
+
+type trimIAMRolePathFunction struct{}
+
+func (f trimIAMRolePathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_iam_role_path"
+}
+
+func (f trimIAMRolePathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "trim_iam_role_path Function",
+		MarkdownDescription: "Trims the path prefix from an IAM role Amazon Resource Name (ARN). This " +
+			"function can be used when services require role ARNs to be passed without a path.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM role Amazon Resource Name (ARN)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f trimIAMRolePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := trimIAMRolePath(arg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// trimIAMRolePath removes all path components from an IAM role ARN.
+func trimIAMRolePath(s string) (string, error) {
+	if err := validateARN(s); err != nil {
+		return "", err
+	}
+
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	const (
+		resourcePrefix = "role/"
+	)
+	if v.Service != "iam" || !strings.HasPrefix(v.Resource, resourcePrefix) {
+		return "", fmt.Errorf("%q is not an IAM role ARN", s)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(v.Resource, resourcePrefix), "/")
+	name := parts[len(parts)-1]
+	if name == "" {
+		return "", fmt.Errorf("%q is missing an IAM role name", s)
+	}
+
+	v.Resource = resourcePrefix + name
+
+	return v.String(), nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestTrimIAMRolePathFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn           string
+		expected      string
+		expectedError bool
+	}{
+		"aws no path": {
+			arn:      "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+		},
+		"aws": {
+			arn:      "arn:aws:iam::444455556666:role/path/to/example", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example",         // lintignore:AWSAT005
+		},
+		"aws-cn": {
+			arn:      "arn:aws-cn:iam::444455556666:role/path/example", // lintignore:AWSAT005
+			expected: "arn:aws-cn:iam::444455556666:role/example",      // lintignore:AWSAT005
+		},
+		"aws-us-gov": {
+			arn:      "arn:aws-us-gov:iam::444455556666:role/path/example", // lintignore:AWSAT005
+			expected: "arn:aws-us-gov:iam::444455556666:role/example",      // lintignore:AWSAT005
+		},
+		"aws-iso": {
+			arn:      "arn:aws-iso:iam::444455556666:role/path/example", // lintignore:AWSAT005
+			expected: "arn:aws-iso:iam::444455556666:role/example",      // lintignore:AWSAT005
+		},
+		"aws-iso-b": {
+			arn:      "arn:aws-iso-b:iam::444455556666:role/path/example", // lintignore:AWSAT005
+			expected: "arn:aws-iso-b:iam::444455556666:role/example",      // lintignore:AWSAT005
+		},
+		"service role path": {
+			arn:      "arn:aws:iam::444455556666:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/AWSServiceRoleForAutoScaling",                                            // lintignore:AWSAT005
+		},
+		"not an ARN": {
+			arn:           "example",
+			expectedError: true,
+		},
+		"not an IAM ARN": {
+			arn:           "arn:aws:sts::444455556666:assumed-role/example/session", // lintignore:AWSAT005
+			expectedError: true,
+		},
+		"not a role ARN": {
+			arn:           "arn:aws:iam::444455556666:user/path/example", // lintignore:AWSAT005
+			expectedError: true,
+		},
+		"missing role name": {
+			arn:           "arn:aws:iam::444455556666:role/path/", // lintignore:AWSAT005
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			f := tffunction.NewTrimIAMRolePathFunction()
+
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.arn)}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			f.Run(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Run(%q) error = %t, want %t: %v", testCase.arn, got, want, response.Error)
+			}
+
+			if testCase.expectedError {
+				return
+			}
+
+			if got, want := response.Result.Value(), types.StringValue(testCase.expected); !got.Equal(want) {
+				t.Errorf("Run(%q) = %v, want %v", testCase.arn, got, want)
+			}
+		})
+	}
+}
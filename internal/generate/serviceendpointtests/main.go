@@ -31,22 +31,7 @@ func main() {
 	for _, l := range services {
 		packageName := l.ProviderPackage()
 
-		switch packageName {
-		case "codecatalyst", // Bearer auth token needs special handling
-			"s3control",       // Resolver modifies URL
-			"timestreamwrite": // Use endpoint discovery
-			continue
-		}
-
-		if !l.ClientSDKV2() {
-			continue
-		}
-
-		if len(l.Aliases()) > 0 {
-			continue
-		}
-
-		if l.DeprecatedEnvVar() != "" || l.TfAwsEnvVar() != "" {
+		if l.Exclude() || l.NotImplemented() {
 			continue
 		}
 
@@ -61,15 +46,29 @@ func main() {
 			APICallParams:     l.EndpointAPIParams(),
 			AwsEnvVar:         l.AwsServiceEnvVar(),
 			ConfigParameter:   l.AwsConfigParameter(),
+			DeprecatedEnvVar:  l.DeprecatedEnvVar(),
+			TfAwsEnvVar:       l.TfAwsEnvVar(),
+		}
+
+		if len(l.Aliases()) > 0 {
+			td.Alias = l.Aliases()[0]
+		}
+
+		// Services without an AWS SDK for Go v2 client are tested using the AWS SDK for Go v1 client's endpoint.
+		if !l.ClientSDKV2() {
+			td.ClientSDKV1 = true
+			td.GoV1Package = l.GoV1Package()
+			td.APICall = ""
 		}
 
 		switch packageName {
 		case "route53domains":
 			td.Region = "us-east-1"
-		}
-
-		if td.APICall == "" {
-			td.APICall = "PLACEHOLDER"
+		case "codecatalyst", // Bearer auth token needs special handling
+			"s3control",       // Resolver modifies URL
+			"timestreamwrite": // Use endpoint discovery
+			// Tested using the API client's configured endpoint.
+			td.APICall = ""
 		}
 
 		d := g.NewGoFileDestination(filepath.Join(relativePath, packageName, filename))
@@ -86,6 +85,8 @@ func main() {
 
 type TemplateData struct {
 	PackageName       string
+	ClientSDKV1       bool
+	GoV1Package       string
 	GoV2Package       string
 	ProviderNameUpper string
 	Region            string
@@ -93,6 +94,9 @@ type TemplateData struct {
 	APICallParams     string
 	AwsEnvVar         string
 	ConfigParameter   string
+	Alias             string
+	TfAwsEnvVar       string
+	DeprecatedEnvVar  string
 }
 
 //go:embed file.tmpl
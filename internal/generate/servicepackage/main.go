@@ -14,6 +14,7 @@ import (
 	"go/token"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -52,10 +53,12 @@ func main() {
 		v := &visitor{
 			g: g,
 
-			frameworkDataSources: make([]ResourceDatum, 0),
-			frameworkResources:   make([]ResourceDatum, 0),
-			sdkDataSources:       make(map[string]ResourceDatum),
-			sdkResources:         make(map[string]ResourceDatum),
+			frameworkDataSources:   make([]ResourceDatum, 0),
+			frameworkResources:     make([]ResourceDatum, 0),
+			frameworkListResources: make(map[string]ResourceDatum),
+			ephemeralResources:     make(map[string]ResourceDatum),
+			sdkDataSources:         make(map[string]ResourceDatum),
+			sdkResources:           make(map[string]ResourceDatum),
 		}
 
 		v.processDir(".")
@@ -65,15 +68,17 @@ func main() {
 		}
 
 		s := ServiceDatum{
-			SkipClientGenerate:   l.SkipClientGenerate(),
-			GoV1Package:          l.GoV1Package(),
-			GoV2Package:          l.GoV2Package(),
-			ProviderPackage:      p,
-			ProviderNameUpper:    l.ProviderNameUpper(),
-			FrameworkDataSources: v.frameworkDataSources,
-			FrameworkResources:   v.frameworkResources,
-			SDKDataSources:       v.sdkDataSources,
-			SDKResources:         v.sdkResources,
+			SkipClientGenerate:     l.SkipClientGenerate(),
+			GoV1Package:            l.GoV1Package(),
+			GoV2Package:            l.GoV2Package(),
+			ProviderPackage:        p,
+			ProviderNameUpper:      l.ProviderNameUpper(),
+			FrameworkDataSources:   v.frameworkDataSources,
+			FrameworkResources:     v.frameworkResources,
+			FrameworkListResources: v.frameworkListResources,
+			EphemeralResources:     v.ephemeralResources,
+			SDKDataSources:         v.sdkDataSources,
+			SDKResources:           v.sdkResources,
 		}
 
 		s.SDKVersion = l.SDKVersion()
@@ -108,20 +113,33 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsPolicyResourceType  string
+	RegionAnnotated         bool
+	RegionIsGlobal          bool
+	RegionOverrideEnabled   bool
+	IdentityAttributes      []IdentityAttributeDatum
+	IDAttrShadowsAttr       string
+}
+
+type IdentityAttributeDatum struct {
+	Name     string
+	Required bool
 }
 
 type ServiceDatum struct {
-	SkipClientGenerate   bool
-	SDKVersion           string // AWS SDK for Go version ("1", "2" or "1,2")
-	GoV1Package          string // AWS SDK for Go v1 package name
-	GoV1ClientTypeName   string // AWS SDK for Go v1 client type name
-	GoV2Package          string // AWS SDK for Go v2 package name
-	ProviderPackage      string
-	ProviderNameUpper    string
-	FrameworkDataSources []ResourceDatum
-	FrameworkResources   []ResourceDatum
-	SDKDataSources       map[string]ResourceDatum
-	SDKResources         map[string]ResourceDatum
+	SkipClientGenerate     bool
+	SDKVersion             string // AWS SDK for Go version ("1", "2" or "1,2")
+	GoV1Package            string // AWS SDK for Go v1 package name
+	GoV1ClientTypeName     string // AWS SDK for Go v1 client type name
+	GoV2Package            string // AWS SDK for Go v2 package name
+	ProviderPackage        string
+	ProviderNameUpper      string
+	FrameworkDataSources   []ResourceDatum
+	FrameworkResources     []ResourceDatum
+	FrameworkListResources map[string]ResourceDatum
+	EphemeralResources     map[string]ResourceDatum
+	SDKDataSources         map[string]ResourceDatum
+	SDKResources           map[string]ResourceDatum
 }
 
 //go:embed file.tmpl
@@ -140,10 +158,12 @@ type visitor struct {
 	functionName string
 	packageName  string
 
-	frameworkDataSources []ResourceDatum
-	frameworkResources   []ResourceDatum
-	sdkDataSources       map[string]ResourceDatum
-	sdkResources         map[string]ResourceDatum
+	frameworkDataSources   []ResourceDatum
+	frameworkResources     []ResourceDatum
+	frameworkListResources map[string]ResourceDatum
+	ephemeralResources     map[string]ResourceDatum
+	sdkDataSources         map[string]ResourceDatum
+	sdkResources           map[string]ResourceDatum
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -181,16 +201,46 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a Plugin Framework ephemeral or list resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging, Region and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if d.RegionAnnotated {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple Region annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.RegionAnnotated = true
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid Region global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionIsGlobal = global
+				}
+			}
+
+			// Per-resource Region override is enabled by default for non-global resources.
+			d.RegionOverrideEnabled = !d.RegionIsGlobal
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if overrideEnabled, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid Region overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionOverrideEnabled = overrideEnabled
+				}
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -207,6 +257,51 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["tagPolicyResourceType"]; ok {
+				d.TagsPolicyResourceType = attr
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("no IdentityAttribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			identityAttribute := IdentityAttributeDatum{
+				Name:     args.Positional[0],
+				Required: true,
+			}
+
+			if slices.ContainsFunc(d.IdentityAttributes, func(a IdentityAttributeDatum) bool { return a.Name == identityAttribute.Name }) {
+				v.err = multierror.Append(v.err, fmt.Errorf("duplicate IdentityAttribute (%s): %s", identityAttribute.Name, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			if attr, ok := args.Keyword["optional"]; ok {
+				if optional, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid IdentityAttribute optional value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					identityAttribute.Required = !optional
+				}
+			}
+
+			if attr, ok := args.Keyword["idAttrShadows"]; ok {
+				if shadows, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid IdentityAttribute idAttrShadows value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else if shadows {
+					if d.IDAttrShadowsAttr != "" {
+						v.err = multierror.Append(v.err, fmt.Errorf("multiple IdentityAttribute idAttrShadows values: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					}
+
+					d.IDAttrShadowsAttr = identityAttribute.Name
+				}
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, identityAttribute)
 		}
 	}
 
@@ -235,6 +330,32 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
+			case "FrameworkListResource":
+				if len(args.Positional) == 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.frameworkListResources[typeName]; ok {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.frameworkListResources[typeName] = d
+				}
+			case "EphemeralResource":
+				if len(args.Positional) == 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.ephemeralResources[typeName]; ok {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Ephemeral Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -261,7 +382,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
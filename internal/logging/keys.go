@@ -6,3 +6,13 @@ package logging
 const (
 	KeyResourceId = "id"
 )
+
+// Keys of the fields logged for each AWS API call attempt.
+const (
+	KeyAPIAttempt   = "tf_aws.api.attempt"
+	KeyAPILatency   = "tf_aws.api.latency_ms"
+	KeyAPIOperation = "tf_aws.api.operation"
+	KeyAPIRegion    = "tf_aws.api.region"
+	KeyAPIRequestID = "tf_aws.api.request_id"
+	KeyAPIService   = "tf_aws.api.service"
+)
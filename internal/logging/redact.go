@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+)
+
+// DefaultRedactedFields are the AWS API request and response body fields whose values are always redacted from logs.
+var DefaultRedactedFields = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"UserData",
+}
+
+// RedactionExpressions returns regular expressions matching the specified fields, and their values,
+// in JSON, XML and query string AWS API request and response bodies.
+// The default redacted fields are always included.
+func RedactionExpressions(fields ...string) []*regexp.Regexp {
+	fields = append(slices.Clone(DefaultRedactedFields), fields...)
+	slices.Sort(fields)
+	fields = slices.Compact(fields)
+
+	expressions := make([]*regexp.Regexp, 0, len(fields))
+	for _, field := range fields {
+		if field == "" {
+			continue
+		}
+
+		field = regexp.QuoteMeta(field)
+		expressions = append(expressions, regexache.MustCompile(fmt.Sprintf(
+			`"%[1]s"\s*:\s*(?:"(?:[^"\\]|\\.)*"|\[[^\]]*\]|\{[^}]*\})|<%[1]s>[^<]*</%[1]s>|\b%[1]s=[^&\s]*`,
+			field,
+		)))
+	}
+
+	return expressions
+}
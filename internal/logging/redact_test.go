@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+func TestRedactionExpressions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fields   []string
+		input    string
+		expected string
+	}{
+		"no redaction": {
+			input:    `{"Name":"example","Description":"test"}`,
+			expected: `{"Name":"example","Description":"test"}`,
+		},
+		"JSON string": {
+			input:    `{"Name":"example","SecretString":"s3cr3t"}`,
+			expected: `{"Name":"example",***}`,
+		},
+		"JSON string with escaped quotes": {
+			input:    `{"SecretString": "{\"password\":\"s3cr3t\"}","Name":"example"}`,
+			expected: `{***,"Name":"example"}`,
+		},
+		"JSON list": {
+			input:    `{"UserData":["a","b"]}`,
+			expected: `{***}`,
+		},
+		"JSON field name suffix": {
+			input:    `{"MasterUserPassword":"s3cr3t","PasswordLength":8}`,
+			expected: `{***,"PasswordLength":8}`,
+		},
+		"XML": {
+			input:    `<CreateUser><Password>s3cr3t</Password><UserName>example</UserName></CreateUser>`,
+			expected: `<CreateUser>***<UserName>example</UserName></CreateUser>`,
+		},
+		"query string": {
+			input:    `Action=RunInstances&UserData=ZWNobyBoZWxsbw%3D%3D&Version=2016-11-15`,
+			expected: `Action=RunInstances&***&Version=2016-11-15`,
+		},
+		"query string nested": {
+			input:    `Action=CreateLaunchTemplate&LaunchTemplateData.UserData=ZWNobyBoZWxsbw%3D%3D`,
+			expected: `Action=CreateLaunchTemplate&LaunchTemplateData.***`,
+		},
+		"additional field": {
+			fields:   []string{"ApiKey"},
+			input:    `{"ApiKey":"abc123","Name":"example"}`,
+			expected: `{***,"Name":"example"}`,
+		},
+		"additional field not configured": {
+			input:    `{"ApiKey":"abc123","Name":"example"}`,
+			expected: `{"ApiKey":"abc123","Name":"example"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input
+			for _, re := range logging.RedactionExpressions(testCase.fields...) {
+				got = re.ReplaceAllString(got, "***")
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
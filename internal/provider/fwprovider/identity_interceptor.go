@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityResourceSchema returns the identity schema for a resource.
+// The AWS account ID and (for non-global resources) the AWS Region are optional when importing
+// and default to the values from the provider configuration.
+func identityResourceSchema(identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+			Description:       "The AWS account ID of the resource. Defaults to the account ID of the provider's credentials.",
+		},
+	}
+
+	if !identity.IsGlobalResource {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+			Description:       "The AWS Region of the resource. Defaults to the Region set in the provider configuration.",
+		}
+	}
+
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: v.Required,
+			OptionalForImport: !v.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// hasStateAttribute returns whether or not the resource schema defines the specified top-level attribute.
+func hasStateAttribute(ctx context.Context, state tfsdk.State, name string) bool {
+	_, diags := state.Schema.AttributeAtPath(ctx, path.Root(name))
+
+	return !diags.HasError()
+}
+
+// getStateString returns the value of a top-level string attribute from state, or "" if the attribute is not defined in the schema.
+func getStateString(ctx context.Context, state tfsdk.State, name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !hasStateAttribute(ctx, state, name) {
+		return "", diags
+	}
+
+	// Use a Go type so that custom string types (e.g. ARNs) can be read.
+	var v *string
+	diags.Append(state.GetAttribute(ctx, path.Root(name), &v)...)
+	if diags.HasError() || v == nil {
+		return "", diags
+	}
+
+	return *v, diags
+}
+
+// setIdentity sets the resource's identity from the values in state.
+func setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, spec *types.ServicePackageResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	accountID, d := getStateString(ctx, state, names.AttrAccountID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if accountID == "" {
+		accountID = meta.AccountID
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), accountID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !spec.IsGlobalResource {
+		region, d := getStateString(ctx, state, names.AttrRegion)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if region == "" {
+			region = meta.RegionForContext(ctx)
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, v := range spec.Attributes {
+		switch v.Name {
+		case names.AttrAccountID, names.AttrRegion:
+			// Handled above.
+			continue
+		}
+
+		value, d := getStateString(ctx, state, v.Name)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(v.Name), value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// identityResourceInterceptor sets a resource's identity following a successful Create, Read or Update.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(setIdentity(ctx, response.State, response.Identity, r.identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(setIdentity(ctx, response.State, response.Identity, r.identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(setIdentity(ctx, response.State, response.Identity, r.identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// identityImportState validates the AWS account ID and AWS Region of a resource imported by identity.
+// Per-resource Region override is applied if enabled.
+func identityImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, spec *types.ServicePackageResourceIdentity, meta *conns.AWSClient, regionOverrideEnabled bool) context.Context {
+	// Importing by ID.
+	if request.ID != "" || request.Identity == nil {
+		return ctx
+	}
+
+	var accountID fwtypes.String
+	response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if v := accountID.ValueString(); v != "" {
+		if hasStateAttribute(ctx, response.State, names.AttrAccountID) {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAccountID), v)...)
+			if response.Diagnostics.HasError() {
+				return ctx
+			}
+		} else if v != meta.AccountID {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrAccountID),
+				"Invalid Identity Attribute",
+				fmt.Sprintf("The account ID %q does not match the account ID of the provider's credentials (%s).", v, meta.AccountID),
+			)
+
+			return ctx
+		}
+	}
+
+	if spec.IsGlobalResource {
+		return ctx
+	}
+
+	var region fwtypes.String
+	response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if v := region.ValueString(); v != "" {
+		if regionOverrideEnabled {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), v)...)
+			if response.Diagnostics.HasError() {
+				return ctx
+			}
+
+			setOverrideRegion(ctx, v)
+		} else if v != meta.Region {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrRegion),
+				"Invalid Identity Attribute",
+				fmt.Sprintf("The Region %q does not match the Region set in the provider configuration (%s).", v, meta.Region),
+			)
+
+			return ctx
+		}
+	}
+
+	return ctx
+}
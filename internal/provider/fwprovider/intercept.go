@@ -9,6 +9,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,6 +36,13 @@ type dataSourceInterceptor interface {
 
 type dataSourceInterceptors []dataSourceInterceptor
 
+// read returns a slice of interceptors that run on data source Read.
+func (s dataSourceInterceptors) read() []interceptorFunc[datasource.ReadRequest, datasource.ReadResponse] {
+	return slices.ApplyToAll(s, func(e dataSourceInterceptor) interceptorFunc[datasource.ReadRequest, datasource.ReadResponse] {
+		return e.read
+	})
+}
+
 type resourceCRUDRequest interface {
 	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
 }
@@ -40,6 +50,13 @@ type resourceCRUDResponse interface {
 	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
 }
 
+type interceptedRequest interface {
+	resourceCRUDRequest | datasource.ReadRequest
+}
+type interceptedResponse interface {
+	resourceCRUDResponse | datasource.ReadResponse
+}
+
 // A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
@@ -57,32 +74,32 @@ type resourceInterceptor interface {
 
 type resourceInterceptors []resourceInterceptor
 
-type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+type interceptorFunc[Request interceptedRequest, Response interceptedResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // create returns a slice of interceptors that run on resource Create.
-func (s resourceInterceptors) create() []resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+func (s resourceInterceptors) create() []interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
 		return e.create
 	})
 }
 
 // read returns a slice of interceptors that run on resource Read.
-func (s resourceInterceptors) read() []resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+func (s resourceInterceptors) read() []interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
 		return e.read
 	})
 }
 
 // update returns a slice of interceptors that run on resource Update.
-func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+func (s resourceInterceptors) update() []interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
 		return e.update
 	})
 }
 
 // delete returns a slice of interceptors that run on resource Delete.
-func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+func (s resourceInterceptors) delete() []interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
 		return e.delete
 	})
 }
@@ -99,7 +116,7 @@ const (
 )
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
+func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
@@ -147,13 +164,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	regionOverrideEnabled bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionOverrideEnabled bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		interceptors:          interceptors,
+		regionOverrideEnabled: regionOverrideEnabled,
 	}
 }
 
@@ -165,12 +185,20 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceSchemaAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		w.inner.Read(ctx, request, response)
+		return response.Diagnostics
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	// TODO Run interceptors.
-	w.inner.Read(ctx, request, response)
+	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -187,7 +215,51 @@ type tagsDataSourceInterceptor struct {
 }
 
 func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	// TODO
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Get the data source's configured tags.
+		var configTags fwtypes.Map
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		tagsInContext.TagsIn = option.Some(tftags.New(ctx, configTags))
+	case After:
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		// If the R handler didn't set tags, it has set them in state itself.
+		if tagsInContext.TagsOut.IsNone() {
+			return ctx, diags
+		}
+
+		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+		tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+		stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map())
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+	}
+
 	return ctx, diags
 }
 
@@ -198,14 +270,27 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	regionOverrideEnabled bool
+	// identity is the resource's identity specification, if any.
+	identity *types.ServicePackageResourceIdentity
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionOverrideEnabled bool, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	w := &wrappedResource{
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		interceptors:          interceptors,
+		regionOverrideEnabled: regionOverrideEnabled,
+		identity:              identity,
+	}
+
+	// Only resources with an identity may implement the ResourceWithIdentity interface.
+	if identity != nil {
+		return &wrappedResourceWithIdentity{wrappedResource: w}
 	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -216,6 +301,10 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		response.Schema.Attributes[names.AttrRegion] = regionResourceSchemaAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -269,6 +358,18 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverrideEnabled {
+			ctx = regionImportState(ctx, &request, response)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+		if w.identity != nil {
+			ctx = identityImportState(ctx, request, response, w.identity, w.meta, w.regionOverrideEnabled)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 		v.ImportState(ctx, request, response)
 
 		return
@@ -281,8 +382,15 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	if w.regionOverrideEnabled {
+		ctx = regionModifyPlan(ctx, request, response, w.meta)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 
 		return
@@ -315,6 +423,151 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that has an identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityResourceSchema(w.identity)
+}
+
+// wrappedEphemeralResource represents a dispatcher for a Plugin Framework ephemeral resource.
+// Ephemeral resources are never persisted in plan or state so no interceptors are run.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+// wrappedListResource represents a dispatcher for a Plugin Framework list resource.
+// List results are streamed so no interceptors are run.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            list.ListResourceWithConfigure
+	meta             *conns.AWSClient
+	// regionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	regionOverrideEnabled bool
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResourceWithConfigure, regionOverrideEnabled bool) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		regionOverrideEnabled: regionOverrideEnabled,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]listschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionListResourceSchemaAttribute()
+	}
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionOverrideEnabled {
+		region, diags := getRegionAttribute(ctx, request.Config.GetAttribute)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		setOverrideRegion(ctx, region)
+	}
+
+	w.inner.List(ctx, request, stream)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -7,18 +7,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,6 +37,12 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var (
+	_ provider.ProviderWithEphemeralResources = (*fwprovider)(nil)
+	_ provider.ProviderWithFunctions          = (*fwprovider)(nil)
+	_ provider.ProviderWithListResources      = (*fwprovider)(nil)
+)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -79,6 +92,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"log_redacted_fields": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Names of AWS API request and response fields whose values are redacted from logs, in addition to the secret-bearing fields that are always redacted.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -143,6 +161,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File containing an AWS Organizations tag policy in JSON format. The tags of each resource are checked for compliance with the tag policy when planning.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -158,9 +180,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume, in order. Each role is assumed using the credentials of the role before it.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -250,6 +270,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"propagate_to_child_resources": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to also apply the default resource tags to child resources implicitly created by a resource",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -279,6 +303,45 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to limit the rate of AWS API requests made by each service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of AWS API requests that can be made at once by each service. Defaults to `requests_per_second` rounded up.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained rate of AWS API requests made by each service. Services not otherwise configured are not rate limited if not set.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"service": schema.ListNestedBlock{
+							Description: "Per-service rate limits, overriding `requests_per_second` and `burst`.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"burst": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The maximum number of AWS API requests that can be made at once by the service. Defaults to `requests_per_second` rounded up.",
+									},
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "The service, using the same name as in the `endpoints` block.",
+									},
+									"requests_per_second": schema.Float64Attribute{
+										Required:    true,
+										Description: "The sustained rate of AWS API requests made by the service.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -291,6 +354,8 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	v := p.Primary.Meta()
 	response.DataSourceData = v
 	response.ResourceData = v
+	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -334,12 +399,17 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			regionOverrideEnabled := isRegionOverrideEnabled(v.Region, schemaResponse.Schema.Attributes)
+			if regionOverrideEnabled {
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -354,7 +424,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regionOverrideEnabled)
 			})
 		}
 	}
@@ -396,6 +466,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if inContext, ok := conns.FromContext(ctx); ok && v.Tags != nil {
+					inContext.TagPolicyResourceType = v.Tags.PolicyResourceType
+				}
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -405,12 +478,18 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			// Per-resource Region override must be handled before any other interceptor makes AWS API calls.
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			regionOverrideEnabled := isRegionOverrideEnabled(v.Region, schemaResponse.Schema.Attributes)
+			if regionOverrideEnabled {
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -433,8 +512,34 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema looks OK.
+				if i := slices.IndexFunc(v.Identity.Attributes, func(attr itypes.IdentityAttribute) bool {
+					_, ok := schemaResponse.Schema.Attributes[attr.Name]
+					return !ok
+				}); i != -1 {
+					errs = append(errs, fmt.Errorf("identity attribute `%s` not defined in schema: %s", v.Identity.Attributes[i].Name, typeName))
+					continue
+				}
+				if v.Identity.IDAttrShadowsAttr != "" {
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrID]; !ok {
+						errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrID, typeName))
+						continue
+					}
+				}
+
+				if inner, ok := inner.(interface {
+					SetIdentitySpec(itypes.ServicePackageResourceIdentity)
+				}); ok {
+					inner.SetIdentitySpec(*v.Identity)
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, regionOverrideEnabled, v.Identity)
 			})
 		}
 	}
@@ -448,6 +553,185 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource (%s): %w", v.TypeName, err))
+				continue
+			}
+
+			metadataResponse := ephemeral.MetadataResponse{}
+			inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+
+			if typeName := metadataResponse.TypeName; typeName != v.TypeName {
+				errs = append(errs, fmt.Errorf("ephemeral resource type name (%s) does not match annotation (%s)", typeName, v.TypeName))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name is determined by the ListResource implementing
+// the Metadata method. All list resources must have unique names and must
+// correspond to a Plugin SDK resource that supports resource identity.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	// List resources read instances of the primary provider's (wrapped) Plugin SDK resources.
+	primary, ok := p.Primary.(*sdkschema.Provider)
+	if !ok {
+		return listResources
+	}
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithFrameworkListResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+		sdkResources := sp.SDKResources(ctx)
+
+		for _, v := range v.FrameworkListResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating list resource (%s): %w", v.TypeName, err))
+				continue
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+			if typeName := metadataResponse.TypeName; typeName != v.TypeName {
+				errs = append(errs, fmt.Errorf("list resource type name (%s) does not match annotation (%s)", typeName, v.TypeName))
+				continue
+			}
+
+			i := slices.IndexFunc(sdkResources, func(e *itypes.ServicePackageSDKResource) bool {
+				return e.TypeName == v.TypeName
+			})
+			if i == -1 {
+				errs = append(errs, fmt.Errorf("no resource defined for list resource: %s", v.TypeName))
+				continue
+			}
+			sdkResource := sdkResources[i]
+
+			identity := sdkResource.Identity
+			if identity == nil {
+				errs = append(errs, fmt.Errorf("resource does not support resource identity: %s", v.TypeName))
+				continue
+			}
+
+			r, ok := primary.ResourcesMap[v.TypeName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("resource not registered: %s", v.TypeName))
+				continue
+			}
+
+			if v, ok := inner.(interface{ SetSDKv2Resource(*sdkschema.Resource) }); ok {
+				v.SetSDKv2Resource(r)
+			}
+			if v, ok := inner.(interface {
+				SetIdentitySpec(itypes.ServicePackageResourceIdentity)
+			}); ok {
+				v.SetIdentitySpec(*identity)
+			}
+
+			// Per-resource Region override is enabled if it is for the corresponding resource.
+			// See isRegionOverrideEnabled in the primary provider.
+			regionOverrideEnabled := !identity.IsGlobalResource
+			if v := sdkResource.Region; v != nil && (v.IsGlobal || !v.IsOverrideEnabled) {
+				regionOverrideEnabled = false
+			} else if _, ok := sdkResource.Factory().SchemaMap()[names.AttrRegion]; ok {
+				regionOverrideEnabled = false
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			listResources = append(listResources, func() list.ListResource {
+				return newWrappedListResource(bootstrapContext, inner, regionOverrideEnabled)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function name is determined by the Function implementing
+// the Metadata method. All functions must have unique names.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		tffunction.NewARNBuildFunction,
+		tffunction.NewARNParseFunction,
+		tffunction.NewTrimIAMRolePathFunction,
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
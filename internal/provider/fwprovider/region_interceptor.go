@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether or not a Plugin Framework resource or data source supports per-resource Region override.
+// Unless the resource is annotated otherwise, per-resource Region override is enabled.
+// The `region` attribute is injected into the schema, so the resource's model must embed framework.WithRegionModel.
+func isRegionOverrideEnabled[T any](v *types.ServicePackageResourceRegion, attributes map[string]T) bool {
+	if v != nil && (v.IsGlobal || !v.IsOverrideEnabled) {
+		return false
+	}
+
+	// Don't clobber any existing attribute. Some resources already use `region` for another purpose.
+	if _, ok := attributes[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+// regionResourceSchemaAttribute returns the schema for the `region` attribute injected into resources.
+func regionResourceSchemaAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+		Description: "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionDataSourceSchemaAttribute returns the schema for the `region` attribute injected into data sources.
+func regionDataSourceSchemaAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionListResourceSchemaAttribute returns the schema for the `region` attribute injected into list resources.
+func regionListResourceSchemaAttribute() listschema.Attribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "The AWS Region in which to list resources. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// getRegionAttribute returns any known `region` value from configuration, plan or state.
+func getRegionAttribute(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) (string, diag.Diagnostics) {
+	var region fwtypes.String
+	diags := getAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+	return region.ValueString(), diags
+}
+
+// setOverrideRegion sets any per-resource Region override in Context.
+func setOverrideRegion(ctx context.Context, region string) {
+	if region == "" {
+		return
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+}
+
+// setRegionInState sets the value of `region` in state if it is not already known.
+func setRegionInState(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	var region fwtypes.String
+	diags.Append(state.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if region.IsNull() || region.IsUnknown() {
+		diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.RegionForContext(ctx))...)
+	}
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := getRegionAttribute(ctx, request.Config.GetAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		setOverrideRegion(ctx, region)
+	case After:
+		diags.Append(setRegionInState(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := getRegionAttribute(ctx, request.Plan.GetAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		setOverrideRegion(ctx, region)
+	case After:
+		diags.Append(setRegionInState(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := getRegionAttribute(ctx, request.State.GetAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		setOverrideRegion(ctx, region)
+	case After:
+		diags.Append(setRegionInState(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := getRegionAttribute(ctx, request.Plan.GetAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		setOverrideRegion(ctx, region)
+	case After:
+		diags.Append(setRegionInState(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := getRegionAttribute(ctx, request.State.GetAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		setOverrideRegion(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+// regionModifyPlan plans the value of `region` for a resource that supports per-resource Region override.
+// A change in the provider's configured Region results in a planned change (and forced replacement) of resources without a configured value.
+func regionModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) context.Context {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return ctx
+	}
+
+	var configRegion fwtypes.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if !configRegion.IsNull() {
+		setOverrideRegion(ctx, configRegion.ValueString())
+
+		return ctx
+	}
+
+	providerRegion := meta.Region
+
+	// Create.
+	if request.State.Raw.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), providerRegion)...)
+
+		return ctx
+	}
+
+	var stateRegion fwtypes.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	// State that predates per-resource Region override has no value.
+	// Don't plan a change just to set it; it's set on the next refresh (or any other update).
+	if stateRegion.ValueString() == "" {
+		var planRegion fwtypes.String
+		response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+		if response.Diagnostics.HasError() {
+			return ctx
+		}
+
+		if planRegion.IsUnknown() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), providerRegion)...)
+		}
+
+		return ctx
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), providerRegion)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if stateRegion.ValueString() != providerRegion {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+
+	return ctx
+}
+
+// regionImportState handles import IDs of the form "<id>@<region>" for resources that support per-resource Region override.
+func regionImportState(ctx context.Context, request *resource.ImportStateRequest, response *resource.ImportStateResponse) context.Context {
+	id, region := verify.SplitImportIDRegion(request.ID)
+	if region == "" {
+		return ctx
+	}
+
+	request.ID = id
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	setOverrideRegion(ctx, region)
+
+	return ctx
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identitySchemaResource returns the identity schema for a Plugin SDK resource.
+// The AWS account ID and (for non-global resources) the AWS Region are optional when importing
+// and default to the values from the provider configuration.
+func identitySchemaResource(identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			m := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The AWS account ID of the resource. Defaults to the account ID of the provider's credentials.",
+				},
+			}
+
+			if !identity.IsGlobalResource {
+				m[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The AWS Region of the resource. Defaults to the Region set in the provider configuration.",
+				}
+			}
+
+			for _, v := range identity.Attributes {
+				m[v.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: v.Required,
+					OptionalForImport: !v.Required,
+				}
+			}
+
+			return m
+		},
+	}
+}
+
+// identityInterceptor sets a resource's identity following a successful Create, Read or Update.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			rd, ok := d.(*schema.ResourceData)
+			if !ok {
+				return ctx, diags
+			}
+
+			if err := setIdentity(ctx, rd, r.identity, meta.(*conns.AWSClient)); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setIdentity sets the resource's identity from the values in resource data.
+func setIdentity(ctx context.Context, d *schema.ResourceData, spec *types.ServicePackageResourceIdentity, meta *conns.AWSClient) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %w", err)
+	}
+
+	if err := identity.Set(names.AttrAccountID, meta.AccountID); err != nil {
+		return fmt.Errorf("setting identity %s: %w", names.AttrAccountID, err)
+	}
+
+	if !spec.IsGlobalResource {
+		if err := identity.Set(names.AttrRegion, meta.RegionForContext(ctx)); err != nil {
+			return fmt.Errorf("setting identity %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	for _, v := range spec.Attributes {
+		var value string
+		switch v.Name {
+		case names.AttrAccountID, names.AttrRegion:
+			// Handled above.
+			continue
+		case names.AttrID:
+			value = d.Id()
+		default:
+			value, _ = d.Get(v.Name).(string)
+		}
+
+		if err := identity.Set(v.Name, value); err != nil {
+			return fmt.Errorf("setting identity %s: %w", v.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// identityImportState handles importing a resource by identity.
+// The resource's ID is set from the identity, and the identity's AWS account ID and AWS Region are validated.
+func identityImportState(spec *types.ServicePackageResourceIdentity, regionOverrideEnabled bool, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// Importing by ID.
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, fmt.Errorf("getting identity: %w", err)
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		if v, ok := identity.GetOk(names.AttrAccountID); ok {
+			if v := v.(string); v != c.AccountID {
+				return nil, fmt.Errorf("identity %s (%s) does not match the account ID of the provider's credentials (%s)", names.AttrAccountID, v, c.AccountID)
+			}
+		}
+
+		if !spec.IsGlobalResource {
+			if v, ok := identity.GetOk(names.AttrRegion); ok {
+				if v := v.(string); regionOverrideEnabled {
+					if err := d.Set(names.AttrRegion, v); err != nil {
+						return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+					}
+
+					if inContext, ok := conns.FromContext(ctx); ok {
+						inContext.OverrideRegion = v
+					}
+				} else if v != c.Region {
+					return nil, fmt.Errorf("identity %s (%s) does not match the Region set in the provider configuration (%s)", names.AttrRegion, v, c.Region)
+				}
+			}
+		}
+
+		for _, v := range spec.Attributes {
+			value, ok := identity.GetOk(v.Name)
+			if !ok {
+				if v.Required {
+					return nil, fmt.Errorf("identity attribute %s is required", v.Name)
+				}
+
+				continue
+			}
+
+			switch v.Name {
+			case names.AttrID:
+				d.SetId(value.(string))
+			default:
+				if err := d.Set(v.Name, value); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", v.Name, err)
+				}
+
+				if v.Name == spec.IDAttrShadowsAttr {
+					d.SetId(value.(string))
+				}
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
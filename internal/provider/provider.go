@@ -17,6 +17,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -63,6 +64,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"propagate_to_child_resources": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to also apply the default resource tags to child resources implicitly created by a resource",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -131,6 +137,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"log_redacted_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Names of AWS API request and response fields whose values are redacted from logs, " +
+					"in addition to the secret-bearing fields that are always redacted.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -150,6 +163,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -226,6 +240,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File containing an AWS Organizations tag policy in JSON format. The tags of each resource are checked for compliance with the tag policy when planning.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -291,6 +310,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if isRegionOverrideEnabled(v.Region, r.SchemaMap()) {
+				injectRegionAttribute(r, regionSchemaDataSource())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -359,6 +388,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if inContext, ok := conns.FromContext(ctx); ok && v.Tags != nil {
+					inContext.TagPolicyResourceType = v.Tags.PolicyResourceType
+				}
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -368,6 +400,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Per-resource Region override must be handled before any other interceptor makes AWS API calls.
+			regionOverrideEnabled := isRegionOverrideEnabled(v.Region, r.SchemaMap())
+			if regionOverrideEnabled {
+				injectRegionAttribute(r, regionSchemaResource())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -403,6 +447,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			identity := v.Identity
+			if identity != nil {
+				schema := r.SchemaMap()
+
+				// The resource has opted in to resource identity.
+				// Ensure that the identity attributes are defined in the schema.
+				var err error
+				for _, v := range identity.Attributes {
+					if _, ok := schema[v.Name]; !ok && v.Name != names.AttrID {
+						err = fmt.Errorf("identity attribute `%s` not defined in schema: %s", v.Name, typeName)
+						break
+					}
+				}
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+
+				r.Identity = identitySchemaResource(identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: identity},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -422,9 +493,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if regionOverrideEnabled {
+						v = regionImportState(v)
+					}
+					if identity != nil {
+						v = identityImportState(identity, regionOverrideEnabled, v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if regionOverrideEnabled {
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -506,14 +590,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -524,20 +617,44 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
-	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		rateLimit, err := expandRateLimit(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.RateLimit = rateLimit
 	}
 
-	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
-		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
+	if v, ok := d.GetOk("tag_policy_file"); ok && v.(string) != "" {
+		tagPolicy, err := tftags.LoadTagPolicy(v.(string))
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		config.Endpoints = endpoints
+		config.TagPolicy = tagPolicy
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	var tfEndpoints []interface{}
+	if v, ok := d.GetOk("endpoints"); ok {
+		tfEndpoints = v.(*schema.Set).List()
 	}
 
+	// Service endpoint environment variables are honored even if there is no endpoints block.
+	endpoints, err := expandEndpoints(ctx, tfEndpoints)
+
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	config.Endpoints = endpoints
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -561,6 +678,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("log_redacted_fields"); ok && v.(*schema.Set).Len() > 0 {
+		config.LogRedactedFields = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -599,9 +720,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order. Each role is assumed using the credentials of the role before it.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -725,6 +846,57 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to limit the rate of AWS API requests made by each service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of AWS API requests that can be made at once by each service. Defaults to `requests_per_second` rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The sustained rate of AWS API requests made by each service. Services not otherwise configured are not rate limited if not set.",
+					ValidateFunc: validation.FloatAtLeast(0.001),
+				},
+				"service": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Per-service rate limits, overriding `requests_per_second` and `burst`.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "The maximum number of AWS API requests that can be made at once by the service. Defaults to `requests_per_second` rounded up.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service, using the same name as in the `endpoints` block.",
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								Description:  "The sustained rate of AWS API requests made by the service.",
+								ValidateFunc: validation.FloatAtLeast(0.001),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -832,6 +1004,59 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandRateLimit(_ context.Context, tfMap map[string]interface{}) (*conns.RateLimitConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	rateLimit := &conns.RateLimitConfig{}
+
+	if v, ok := tfMap["requests_per_second"].(float64); ok && v > 0 {
+		rateLimit.Default = &conns.RateLimit{
+			RequestsPerSecond: v,
+		}
+
+		if v, ok := tfMap["burst"].(int); ok && v > 0 {
+			rateLimit.Default.Burst = v
+		}
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		rateLimit.Services = make(map[string]conns.RateLimit)
+
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			alias := tfMap["name"].(string)
+			pkg, err := names.ProviderPackageForAlias(alias)
+
+			if err != nil {
+				return nil, fmt.Errorf("rate_limit service (%s): %w", alias, err)
+			}
+
+			if _, ok := rateLimit.Services[pkg]; ok {
+				return nil, fmt.Errorf("rate_limit service (%s): duplicate service", alias)
+			}
+
+			serviceRateLimit := conns.RateLimit{
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+
+			if v, ok := tfMap["burst"].(int); ok && v > 0 {
+				serviceRateLimit.Burst = v
+			}
+
+			rateLimit.Services[pkg] = serviceRateLimit
+		}
+	}
+
+	return rateLimit, nil
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -843,6 +1068,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["propagate_to_child_resources"].(bool); ok {
+		defaultConfig.PropagateToChildResources = v
+	}
+
 	return defaultConfig
 }
 
@@ -865,10 +1094,6 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
-	if len(tfList) == 0 {
-		return nil, nil
-	}
-
 	endpoints := make(map[string]string)
 
 	for _, tfMapRaw := range tfList {
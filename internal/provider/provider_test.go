@@ -9,6 +9,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -205,3 +207,111 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name        string
+		tfMap       map[string]interface{}
+		expected    *conns.RateLimitConfig
+		expectedErr bool
+	}{
+		{
+			name: "empty",
+			tfMap: map[string]interface{}{
+				"burst":               0,
+				"requests_per_second": 0.0,
+				"service":             []interface{}{},
+			},
+			expected: &conns.RateLimitConfig{},
+		},
+		{
+			name: "default",
+			tfMap: map[string]interface{}{
+				"burst":               20,
+				"requests_per_second": 10.0,
+				"service":             []interface{}{},
+			},
+			expected: &conns.RateLimitConfig{
+				Default: &conns.RateLimit{Burst: 20, RequestsPerSecond: 10},
+			},
+		},
+		{
+			name: "services",
+			tfMap: map[string]interface{}{
+				"burst":               0,
+				"requests_per_second": 10.0,
+				"service": []interface{}{
+					map[string]interface{}{
+						"burst":               0,
+						"name":                "route53",
+						"requests_per_second": 2.5,
+					},
+					map[string]interface{}{
+						"burst":               5,
+						"name":                "cloudwatchlogs",
+						"requests_per_second": 5.0,
+					},
+				},
+			},
+			expected: &conns.RateLimitConfig{
+				Default: &conns.RateLimit{RequestsPerSecond: 10},
+				Services: map[string]conns.RateLimit{
+					names.Route53: {RequestsPerSecond: 2.5},
+					names.Logs:    {Burst: 5, RequestsPerSecond: 5},
+				},
+			},
+		},
+		{
+			name: "unknown service",
+			tfMap: map[string]interface{}{
+				"service": []interface{}{
+					map[string]interface{}{
+						"name":                "nosuchservice",
+						"requests_per_second": 1.0,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate service",
+			tfMap: map[string]interface{}{
+				"service": []interface{}{
+					map[string]interface{}{
+						"name":                "cloudwatchlogs",
+						"requests_per_second": 1.0,
+					},
+					map[string]interface{}{
+						"name":                "logs",
+						"requests_per_second": 2.0,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandRateLimit(ctx, testCase.tfMap)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("expandRateLimit() err %t, want %t: %s", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether or not a Plugin SDK resource or data source supports per-resource Region override.
+// Unless the resource is annotated otherwise, per-resource Region override is enabled.
+func isRegionOverrideEnabled(v *types.ServicePackageResourceRegion, s map[string]*schema.Schema) bool {
+	if v != nil && (v.IsGlobal || !v.IsOverrideEnabled) {
+		return false
+	}
+
+	// Don't clobber any existing attribute. Some resources already use `region` for another purpose.
+	if _, ok := s[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+// regionSchemaResource returns the schema for the `region` attribute injected into resources.
+func regionSchemaResource() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionSchemaDataSource returns the schema for the `region` attribute injected into data sources.
+func regionSchemaDataSource() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the specified `region` attribute to a resource or data source's schema.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = s
+	}
+}
+
+// regionInterceptor implements per-resource Region override for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Any configured value (or value in state) overrides the provider's Region.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.OverrideRegion = v
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).RegionForContext(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans the value of `region` for a resource that supports per-resource Region override.
+// A change in the provider's configured Region results in a planned change (and forced replacement) of resources without a configured value.
+// Resources whose state predates per-resource Region override have no value in state and are not replaced;
+// the value is set on the next refresh.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+		if inContext, ok := conns.FromContext(ctx); ok {
+			inContext.OverrideRegion = v
+		}
+	}
+
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && config.GetAttr(names.AttrRegion).IsNull() {
+		providerRegion := meta.(*conns.AWSClient).Region
+
+		if o, _ := d.GetChange(names.AttrRegion); d.Id() != "" && o.(string) == "" {
+			return nil
+		}
+
+		if d.Get(names.AttrRegion).(string) != providerRegion {
+			if err := d.SetNew(names.AttrRegion, providerRegion); err != nil {
+				return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// regionImportState handles import IDs of the form "<id>@<region>" for resources that support per-resource Region override.
+func regionImportState(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region := verify.SplitImportIDRegion(d.Id()); region != "" {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// NotFound returns true if the error represents a "resource not found" condition.
+// Specifically, NotFound returns true if the error or a wrapped error is of type
+// retry.NotFoundError.
+func NotFound(err error) bool {
+	var e *sdkretry.NotFoundError // nosemgrep:ci.is-not-found-error
+	return errors.As(err, &e)
+}
@@ -14,9 +14,13 @@ import (
 
 // Options configure a retry loop.
 // Before the ith iteration of the loop, retry.Continue() sleeps for a duraion of BackoffMinDuration * BackoffMultiplier**i, with added jitter.
+// If BackoffMaxDuration is specified the duration is capped at that value, and if BackoffFloorDuration is specified it is raised to at least that value.
 type Options struct {
-	BackoffMinDuration time.Duration
-	BackoffMultiplier  float64 // If specified, must be at least 1.
+	BackoffMinDuration   time.Duration
+	BackoffMultiplier    float64       // If specified, must be at least 1.
+	BackoffMaxDuration   time.Duration // If specified, must be at least BackoffMinDuration.
+	BackoffFloorDuration time.Duration // If specified, must be at most BackoffMaxDuration.
+	NoJitter             bool          // If true, the sleep duration is not randomized.
 }
 
 var defaultOptions = Options{
@@ -46,7 +50,11 @@ func Begin() *Retry {
 // The first call does not sleep.
 func (r *Retry) Continue(ctx context.Context) bool {
 	if r.attempt != 0 {
-		randomizedSleep(ctx, r.backoffDelay())
+		if r.options.NoJitter {
+			sleep(ctx, r.backoffDelay())
+		} else {
+			randomizedSleep(ctx, r.backoffDelay())
+		}
 	}
 	r.attempt++
 	return ctx.Err() == nil
@@ -60,7 +68,14 @@ func (r *Retry) Reset() {
 
 func (r *Retry) backoffDelay() time.Duration {
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
-	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	d := time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	if maxDuration := r.options.BackoffMaxDuration; maxDuration > 0 && d > maxDuration {
+		d = maxDuration
+	}
+	if floorDuration := r.options.BackoffFloorDuration; d < floorDuration {
+		d = floorDuration
+	}
+	return d
 }
 
 // Do not use the default RNG since we do not want different provider instances
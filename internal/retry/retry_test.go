@@ -6,6 +6,7 @@ package retry
 import (
 	"context"
 	"math"
+	"slices"
 	"testing"
 	"time"
 )
@@ -37,6 +38,39 @@ func TestRetry(t *testing.T) {
 	}
 }
 
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+
+	// The polling of the Plugin SDK's retry.StateChangeConf with a MinTimeout of 500ms.
+	r := BeginWithOptions(Options{
+		BackoffMinDuration:   100 * time.Millisecond,
+		BackoffMultiplier:    2,
+		BackoffMaxDuration:   10 * time.Second,
+		BackoffFloorDuration: 500 * time.Millisecond,
+		NoJitter:             true,
+	})
+
+	var got []time.Duration
+	for r.attempt = 1; r.attempt <= 8; r.attempt++ {
+		got = append(got, r.backoffDelay())
+	}
+
+	expected := []time.Duration{
+		500 * time.Millisecond,
+		500 * time.Millisecond,
+		800 * time.Millisecond,
+		1600 * time.Millisecond,
+		3200 * time.Millisecond,
+		6400 * time.Millisecond,
+		10 * time.Second,
+		10 * time.Second,
+	}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
 /*
 ** Comment out for now due to flakiness.
 func TestSleepFor(t *testing.T) {
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// StatusFunc returns the current value of a resource and its status.
+// A retry.NotFoundError indicates that the resource does not (yet, or any longer) exist.
+type StatusFunc[T any] func(context.Context) (T, string, error)
+
+// StateChangeConfOf is a typed equivalent of the Plugin SDK's retry.StateChangeConf.
+// The errors returned when waiting are those of the Plugin SDK, so tfresource.TimedOut and tfresource.SetLastError apply.
+type StateChangeConfOf[T any] struct {
+	Delay                     time.Duration // Wait this time before starting checks
+	Pending                   []string      // States that are "allowed" and will continue trying
+	Refresh                   StatusFunc[T] // Refreshes the current state
+	Target                    []string      // Target state
+	Timeout                   time.Duration // The amount of time to wait before timeout
+	MinTimeout                time.Duration // Smallest time to wait before refreshes
+	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often
+	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
+	ContinuousTargetOccurence int           // Number of times the Target state has to occur continuously
+}
+
+// WaitForState watches a resource and waits for it to reach a target state.
+// If Target is empty, waiting succeeds once the resource is not found.
+// The last value returned by Refresh is returned, even on error.
+func (c *StateChangeConfOf[T]) WaitForState(ctx context.Context) (T, error) {
+	conf := &sdkretry.StateChangeConf{
+		Delay:   c.Delay,
+		Pending: c.Pending,
+		Refresh: func() (interface{}, string, error) {
+			t, status, err := c.Refresh(ctx)
+
+			if NotFound(err) {
+				return nil, "", nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			return t, status, nil
+		},
+		Target:                    c.Target,
+		Timeout:                   c.Timeout,
+		MinTimeout:                c.MinTimeout,
+		PollInterval:              c.PollInterval,
+		NotFoundChecks:            c.NotFoundChecks,
+		ContinuousTargetOccurence: c.ContinuousTargetOccurence,
+	}
+
+	outputRaw, err := conf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(T); ok {
+		return output, err
+	}
+
+	var zero T
+	return zero, err
+}
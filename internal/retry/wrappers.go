@@ -9,7 +9,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 )
 
 type Op[T any] interface {
@@ -35,7 +36,9 @@ func (f PredicateFunc[T]) Invoke(t T, err error) (bool, error) {
 type operation[T any] struct {
 	op                Op[T]
 	predicate         Predicate[T]
+	retryable         func(error) bool
 	transformRunError func(error) error
+	options           Options
 }
 
 // Operation returns a new wrapper on top of the specified function.
@@ -48,21 +51,82 @@ func Operation[T any](op OpFunc[T]) operation[T] {
 		}),
 		// The default error transformer does nothing.
 		transformRunError: func(err error) error { return err },
+		options:           defaultOptions,
 	}
 }
 
 func (o operation[T]) withPredicate(predicate Predicate[T]) operation[T] {
-	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError}
+	o.predicate = predicate
+	o.retryable = nil
+	return o
 }
 
 func (o operation[T]) withTransformRunError(f func(error) error) operation[T] {
-	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f}
+	o.transformRunError = f
+	return o
+}
+
+// WithBackoff sets the backoff used between invocations of the operation.
+func (o operation[T]) WithBackoff(options Options) operation[T] {
+	o.options = options
+	return o
 }
 
 func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
+// When retries an operation if the error it returns satisfies retryable.
+// Successive calls to When (and the When... helpers) retry if any of the conditions are satisfied.
+func (o operation[T]) When(retryable func(error) bool) operation[T] {
+	if previous := o.retryable; previous != nil {
+		current := retryable
+		retryable = func(err error) bool {
+			return previous(err) || current(err)
+		}
+	}
+
+	o = o.If(func(_ T, err error) (bool, error) {
+		return err != nil && retryable(err), err
+	})
+	o.retryable = retryable
+
+	return o
+}
+
+// WhenAWSErrCodeEquals retries an operation if it returns one of the specified AWS error codes.
+func (o operation[T]) WhenAWSErrCodeEquals(codes ...string) operation[T] { // nosemgrep:ci.aws-in-func-name
+	return o.When(func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...) || tfawserr_sdkv2.ErrCodeEquals(err, codes...)
+	})
+}
+
+// WhenAWSErrMessageContains retries an operation if it returns an AWS error with the specified code containing the specified message.
+func (o operation[T]) WhenAWSErrMessageContains(code, message string) operation[T] { // nosemgrep:ci.aws-in-func-name
+	return o.When(func(err error) bool {
+		return tfawserr.ErrMessageContains(err, code, message) || tfawserr_sdkv2.ErrMessageContains(err, code, message)
+	})
+}
+
+// WhenHTTPStatusCodeEquals retries an operation if it returns one of the specified HTTP status codes.
+func (o operation[T]) WhenHTTPStatusCodeEquals(statusCodes ...int) operation[T] {
+	return o.When(func(err error) bool {
+		return tfawserr_sdkv2.ErrHTTPStatusCodeEquals(err, statusCodes...)
+	})
+}
+
+// WhenNotFound retries an operation if it returns a retry.NotFoundError.
+func (o operation[T]) WhenNotFound() operation[T] {
+	return o.When(NotFound)
+}
+
+// WhenNewResourceNotFound retries an operation if it returns a retry.NotFoundError and isNewResource is true.
+func (o operation[T]) WhenNewResourceNotFound(isNewResource bool) operation[T] {
+	return o.When(func(err error) bool {
+		return isNewResource && NotFound(err)
+	})
+}
+
 // UntilFoundN retries an operation if it returns a retry.NotFoundError.
 func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	if continuousTargetOccurence < 1 {
@@ -82,7 +146,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if NotFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -100,7 +164,7 @@ func (o operation[T]) UntilNotFound() operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if NotFound(err) {
 			return false, nil
 		}
 
@@ -119,18 +183,74 @@ func (o operation[T]) UntilNotFound() operation[T] {
 }
 
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// The operation is always invoked at least once, and once more after the timeout elapses, as the Plugin SDK's retry.RetryContext does.
+// If the timeout elapses, the last error returned by the predicate is returned, or the context's error if there is none.
+// The returned error also matches context.DeadlineExceeded.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	for r := Begin(); r.Continue(ctx); {
-		t, err := o.op.Invoke(ctx)
+	var lastErr error
+	for r := BeginWithOptions(o.options); r.Continue(runCtx); {
+		t, err := o.op.Invoke(runCtx)
 
-		if retry, err := o.predicate.Invoke(t, err); !retry {
+		retry, err := o.predicate.Invoke(t, err)
+		if !retry {
 			return t, err
 		}
+
+		lastErr = err
+	}
+
+	err := runCtx.Err()
+
+	// The timeout elapsed (as opposed to the caller's context being done): make one final attempt.
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		t, predicateErr := o.op.Invoke(ctx)
+
+		retry, predicateErr := o.predicate.Invoke(t, predicateErr)
+		if !retry {
+			return t, predicateErr
+		}
+
+		if predicateErr != nil {
+			lastErr = predicateErr
+		}
+	}
+
+	if lastErr != nil && errors.Is(err, context.DeadlineExceeded) {
+		err = &timeoutError{lastErr: lastErr}
 	}
 
 	var t T
-	return t, o.transformRunError(ctx.Err())
+	return t, o.transformRunError(err)
+}
+
+// timeoutError is returned by Run when the timeout elapses after a retryable error.
+// It reads as the retryable error and matches both it and context.DeadlineExceeded.
+type timeoutError struct {
+	lastErr error
+}
+
+func (e *timeoutError) Error() string {
+	return e.lastErr.Error()
+}
+
+func (e *timeoutError) Unwrap() []error {
+	return []error{e.lastErr, context.DeadlineExceeded}
+}
+
+// UntilEqual returns a wrapper that retries an operation until it returns a value equal to v.
+func UntilEqual[T comparable](op OpFunc[T], v T) operation[T] {
+	return Operation(op).If(func(t T, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+
+		if t != v {
+			return true, fmt.Errorf("output = %v, want %v", t, v)
+		}
+
+		return false, nil
+	})
 }
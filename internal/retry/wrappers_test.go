@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var testOptions = Options{
+	BackoffMinDuration: time.Millisecond,
+	BackoffMultiplier:  1,
+}
+
+// failTimes returns an operation that fails with err the first n times it is invoked.
+func failTimes(n int, err error) (OpFunc[int], *int) {
+	count := 0
+
+	return func(context.Context) (int, error) {
+		count++
+		if count <= n {
+			return 0, err
+		}
+
+		return count, nil
+	}, &count
+}
+
+func httpResponseError(statusCode int) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{
+				Response: &http.Response{StatusCode: statusCode},
+			},
+			Err: errors.New("test"),
+		},
+	}
+}
+
+func TestOperationWhen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errOther := errors.New("other")
+
+	testCases := []struct {
+		Name          string
+		Err           error
+		Build         func(operation[int]) operation[int]
+		ExpectError   error
+		ExpectRetries bool
+	}{
+		{
+			Name:          "AWS error code",
+			Err:           &smithy.GenericAPIError{Code: "TestCode2"},
+			Build:         func(o operation[int]) operation[int] { return o.WhenAWSErrCodeEquals("TestCode1", "TestCode2") },
+			ExpectRetries: true,
+		},
+		{
+			Name:        "other AWS error code",
+			Err:         &smithy.GenericAPIError{Code: "TestCode3"},
+			Build:       func(o operation[int]) operation[int] { return o.WhenAWSErrCodeEquals("TestCode1", "TestCode2") },
+			ExpectError: &smithy.GenericAPIError{Code: "TestCode3"},
+		},
+		{
+			Name:          "AWS error message",
+			Err:           &smithy.GenericAPIError{Code: "TestCode1", Message: "TestMessage1 happened"},
+			Build:         func(o operation[int]) operation[int] { return o.WhenAWSErrMessageContains("TestCode1", "TestMessage1") },
+			ExpectRetries: true,
+		},
+		{
+			Name:        "other AWS error message",
+			Err:         &smithy.GenericAPIError{Code: "TestCode1", Message: "TestMessage2"},
+			Build:       func(o operation[int]) operation[int] { return o.WhenAWSErrMessageContains("TestCode1", "TestMessage1") },
+			ExpectError: &smithy.GenericAPIError{Code: "TestCode1", Message: "TestMessage2"},
+		},
+		{
+			Name:          "HTTP status code",
+			Err:           httpResponseError(http.StatusConflict),
+			Build:         func(o operation[int]) operation[int] { return o.WhenHTTPStatusCodeEquals(http.StatusConflict) },
+			ExpectRetries: true,
+		},
+		{
+			Name:        "other HTTP status code",
+			Err:         httpResponseError(http.StatusBadRequest),
+			Build:       func(o operation[int]) operation[int] { return o.WhenHTTPStatusCodeEquals(http.StatusConflict) },
+			ExpectError: httpResponseError(http.StatusBadRequest),
+		},
+		{
+			Name:          "not found",
+			Err:           &sdkretry.NotFoundError{},
+			Build:         func(o operation[int]) operation[int] { return o.WhenNotFound() },
+			ExpectRetries: true,
+		},
+		{
+			Name:          "new resource not found",
+			Err:           &sdkretry.NotFoundError{},
+			Build:         func(o operation[int]) operation[int] { return o.WhenNewResourceNotFound(true) },
+			ExpectRetries: true,
+		},
+		{
+			Name:        "existing resource not found",
+			Err:         &sdkretry.NotFoundError{},
+			Build:       func(o operation[int]) operation[int] { return o.WhenNewResourceNotFound(false) },
+			ExpectError: &sdkretry.NotFoundError{},
+		},
+		{
+			Name: "combined conditions",
+			Err:  &sdkretry.NotFoundError{},
+			Build: func(o operation[int]) operation[int] {
+				return o.WhenAWSErrCodeEquals("TestCode1").WhenNotFound()
+			},
+			ExpectRetries: true,
+		},
+		{
+			Name: "If replaces conditions",
+			Err:  errOther,
+			Build: func(o operation[int]) operation[int] {
+				return o.WhenNotFound().If(func(_ int, err error) (bool, error) {
+					return errors.Is(err, errOther), err
+				})
+			},
+			ExpectRetries: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			f, count := failTimes(2, testCase.Err)
+
+			got, err := testCase.Build(Operation(f)).WithBackoff(testOptions).Run(ctx, 5*time.Second)
+
+			if testCase.ExpectError != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if err.Error() != testCase.ExpectError.Error() {
+					t.Errorf("got error %q, expected %q", err, testCase.ExpectError)
+				}
+				if *count != 1 {
+					t.Errorf("got %d invocations, expected 1", *count)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != 3 {
+				t.Errorf("got %d, expected 3", got)
+			}
+		})
+	}
+}
+
+func TestOperationRunTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	err := &sdkretry.NotFoundError{Message: "testing"}
+
+	f, _ := failTimes(1000000, err)
+	_, got := Operation(f).WhenNotFound().WithBackoff(testOptions).Run(ctx, 50*time.Millisecond)
+
+	if !errors.Is(got, err) {
+		t.Errorf("got error %v, expected last error %v", got, err)
+	}
+
+	_, got = Operation(func(context.Context) (int, error) {
+		return 0, nil
+	}).UntilNotFound().WithBackoff(testOptions).Run(ctx, 50*time.Millisecond)
+
+	if !errors.Is(got, context.DeadlineExceeded) {
+		t.Errorf("got error %v, expected %v", got, context.DeadlineExceeded)
+	}
+}
+
+func TestOperationRunFinalAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	err := &sdkretry.NotFoundError{Message: "testing"}
+
+	// A non-positive timeout still invokes the operation once.
+	f, count := failTimes(0, err)
+	got, gotErr := Operation(f).WhenNotFound().WithBackoff(testOptions).Run(ctx, 0)
+
+	if gotErr != nil {
+		t.Fatalf("unexpected error: %s", gotErr)
+	}
+	if got != 1 || *count != 1 {
+		t.Errorf("got %d after %d invocations, expected 1 after 1", got, *count)
+	}
+
+	// The operation is invoked once more after the timeout elapses.
+	var deadline time.Time
+	_, gotErr = Operation(func(ctx context.Context) (int, error) {
+		if ctx.Err() != nil {
+			return 0, errors.New("invoked with a done context")
+		}
+
+		if deadline.IsZero() {
+			deadline, _ = ctx.Deadline()
+		}
+		if time.Now().Before(deadline) {
+			return 0, err
+		}
+
+		return 1, nil
+	}).WhenNotFound().WithBackoff(testOptions).Run(ctx, 50*time.Millisecond)
+
+	if gotErr != nil {
+		t.Fatalf("unexpected error: %s", gotErr)
+	}
+
+	// The timeout error reads as, and matches, the last retryable error.
+	f, _ = failTimes(1000000, err)
+	_, gotErr = Operation(f).WhenNotFound().WithBackoff(testOptions).Run(ctx, 0)
+
+	if !errors.Is(gotErr, err) || !errors.Is(gotErr, context.DeadlineExceeded) {
+		t.Errorf("got error %v, expected %v and %v", gotErr, err, context.DeadlineExceeded)
+	}
+	if got, expected := gotErr.Error(), err.Error(); got != expected {
+		t.Errorf("got error %q, expected %q", got, expected)
+	}
+
+	// No final attempt is made if the caller's context is done.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	f, count = failTimes(0, err)
+	_, gotErr = Operation(f).WhenNotFound().WithBackoff(testOptions).Run(cancelledCtx, 50*time.Millisecond)
+
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("got error %v, expected %v", gotErr, context.Canceled)
+	}
+	if *count != 0 {
+		t.Errorf("got %d invocations, expected 0", *count)
+	}
+}
+
+func TestUntilEqual(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	count := 0
+
+	got, err := UntilEqual(func(context.Context) (string, error) {
+		count++
+		if count < 3 {
+			return "PENDING", nil
+		}
+
+		return "AVAILABLE", nil
+	}, "AVAILABLE").WithBackoff(testOptions).Run(ctx, 5*time.Second)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "AVAILABLE" {
+		t.Errorf("got %s, expected AVAILABLE", got)
+	}
+
+	_, err = UntilEqual(func(context.Context) (string, error) {
+		return "PENDING", nil
+	}, "AVAILABLE").WithBackoff(testOptions).Run(ctx, 50*time.Millisecond)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, expected := err.Error(), "output = PENDING, want AVAILABLE"; got != expected {
+		t.Errorf("got error %q, expected %q", got, expected)
+	}
+}
+
+func TestStateChangeConfOf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type thing struct {
+		Status string
+	}
+
+	statuses := []string{"CREATING", "CREATING", "AVAILABLE"}
+	i := 0
+
+	conf := &StateChangeConfOf[*thing]{
+		Pending: []string{"CREATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func(context.Context) (*thing, string, error) {
+			v := &thing{Status: statuses[i]}
+			if i < len(statuses)-1 {
+				i++
+			}
+
+			return v, v.Status, nil
+		},
+		Timeout:      5 * time.Second,
+		PollInterval: time.Millisecond,
+	}
+
+	got, err := conf.WaitForState(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got == nil || got.Status != "AVAILABLE" {
+		t.Errorf("got %v, expected AVAILABLE", got)
+	}
+
+	// Deleted.
+	conf = &StateChangeConfOf[*thing]{
+		Pending: []string{"DELETING"},
+		Target:  []string{},
+		Refresh: func(context.Context) (*thing, string, error) {
+			return nil, "", &sdkretry.NotFoundError{}
+		},
+		Timeout:      5 * time.Second,
+		PollInterval: time.Millisecond,
+	}
+
+	got, err = conf.WaitForState(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
+
+	// Unexpected state.
+	conf = &StateChangeConfOf[*thing]{
+		Pending: []string{"CREATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func(context.Context) (*thing, string, error) {
+			return &thing{Status: "FAILED"}, "FAILED", nil
+		},
+		Timeout:      5 * time.Second,
+		PollInterval: time.Millisecond,
+	}
+
+	got, err = conf.WaitForState(ctx)
+
+	var unexpectedStateErr *sdkretry.UnexpectedStateError
+	if !errors.As(err, &unexpectedStateErr) {
+		t.Fatalf("got error %v, expected UnexpectedStateError", err)
+	}
+	if got == nil || got.Status != "FAILED" {
+		t.Errorf("got %v, expected FAILED", got)
+	}
+}
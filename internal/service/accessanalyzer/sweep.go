@@ -15,14 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
 }
 
 func sweepAnalyzers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_accessanalyzer_analyzer")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
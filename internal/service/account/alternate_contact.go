@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_account_alternate_contact")
+// @Region(global=true)
 func resourceAlternateContact() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAlternateContactCreate,
@@ -54,7 +54,7 @@ const (
 )
 
 // @SDKResource("aws_acm_certificate", name="Certificate")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="acm:certificate")
 func resourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateCreate,
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 }
 
 func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_acm_certificate")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    sweepCertificateAuthorities,
 	})
 }
 
 func sweepCertificateAuthorities(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_acmpca_certificate_authority")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
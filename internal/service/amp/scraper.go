@@ -388,6 +388,7 @@ func (r *scraperResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 }
 
 type scraperResourceModel struct {
+	framework.WithRegionModel
 	Alias               types.String                                             `tfsdk:"alias"`
 	ARN                 types.String                                             `tfsdk:"arn"`
 	Destination         fwtypes.ListNestedObjectValueOf[scraperDestinationModel] `tfsdk:"destination"`
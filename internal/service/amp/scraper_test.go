@@ -32,7 +32,7 @@ func TestAccAMPScraper_basic(t *testing.T) {
 	eksClusterVersion := "1.28"
 	resourceName := "aws_prometheus_scraper.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AMPEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -73,7 +73,7 @@ func TestAccAMPScraper_disappears(t *testing.T) {
 	eksClusterVersion := "1.28"
 	resourceName := "aws_prometheus_scraper.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AMPEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -103,7 +103,7 @@ func TestAccAMPScraper_tags(t *testing.T) {
 	eksClusterVersion := "1.28"
 	resourceName := "aws_prometheus_scraper.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AMPEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -155,7 +155,7 @@ func TestAccAMPScraper_alias(t *testing.T) {
 	eksClusterVersion := "1.28"
 	resourceName := "aws_prometheus_scraper.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AMPEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -189,7 +189,7 @@ func TestAccAMPScraper_securityGroups(t *testing.T) {
 	eksClusterVersion := "1.28"
 	resourceName := "aws_prometheus_scraper.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AMPEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -62,7 +62,7 @@ func dataSourceWorkspacesRead(ctx context.Context, d *schema.ResourceData, meta
 		workspaceIDs = append(workspaceIDs, aws.ToString(w.WorkspaceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("aliases", aliases)
 	d.Set("arns", arns)
 	d.Set("workspace_ids", workspaceIDs)
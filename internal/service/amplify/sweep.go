@@ -15,14 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
 }
 
 func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_amplify_app")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -19,7 +19,7 @@ func TestAccAPIGatewayAPIKeyDataSource_basic(t *testing.T) {
 	resourceName := "aws_api_gateway_api_key.test"
 	dataSourceName := "data.aws_api_gateway_api_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -18,7 +18,7 @@ func TestAccAPIGatewayAuthorizerDataSource_basic(t *testing.T) {
 	resourceName := "aws_api_gateway_authorizer.test"
 	dataSourceName := "data.aws_api_gateway_authorizer.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -23,7 +23,7 @@ func TestAccAPIGatewayClientCertificate_basic(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -59,7 +59,7 @@ func TestAccAPIGatewayClientCertificate_tags(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -104,7 +104,7 @@ func TestAccAPIGatewayClientCertificate_disappears(t *testing.T) {
 	var conf apigateway.ClientCertificate
 	resourceName := "aws_api_gateway_client_certificate.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccAPIGatewayResourceDataSource_basic(t *testing.T) {
 	resourceName2 := "aws_api_gateway_resource.example_v1_endpoint"
 	dataSourceName2 := "data.aws_api_gateway_resource.example_v1_endpoint"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -19,27 +19,27 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -47,14 +47,14 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
 }
 
 func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_rest_api")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -97,7 +97,7 @@ func sweepRestAPIs(region string) error {
 }
 
 func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_vpc_link")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -135,7 +135,7 @@ func sweepVPCLinks(region string) error {
 }
 
 func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_client_certificate")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -178,7 +178,7 @@ func sweepClientCertificates(region string) error {
 }
 
 func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_usage_plan")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -226,7 +226,7 @@ func sweepUsagePlans(region string) error {
 }
 
 func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_api_key")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -273,7 +273,7 @@ func sweepAPIKeys(region string) error {
 }
 
 func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_api_gateway_domain_name")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
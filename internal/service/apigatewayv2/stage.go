@@ -272,7 +272,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting access_log_settings: %s", err)
 	}
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 	resourceArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "apigateway",
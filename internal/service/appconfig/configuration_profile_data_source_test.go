@@ -21,7 +21,7 @@ func TestAccAppConfigConfigurationProfileDataSource_basic(t *testing.T) {
 	appResourceName := "aws_appconfig_application.test"
 	dataSourceName := "data.aws_appconfig_configuration_profile.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, appconfig.EndpointsID)
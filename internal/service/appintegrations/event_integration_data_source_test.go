@@ -26,7 +26,7 @@ func TestAccAppIntegrationsEventIntegrationDataSource_name(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, appintegrationsservice.EndpointsID)
@@ -35,7 +35,7 @@ func TestAccAppIntegrationsEventIntegration_basic(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, appintegrationsservice.EndpointsID)
@@ -94,7 +94,7 @@ func TestAccAppIntegrationsEventIntegration_updateTags(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, appintegrationsservice.EndpointsID)
@@ -174,7 +174,7 @@ func TestAccAppIntegrationsEventIntegration_disappears(t *testing.T) {
 		sourceName = "aws.partner/examplepartner.com"
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, appintegrationsservice.EndpointsID)
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
 		Name: "aws_applicationinsights_application",
 		F:    sweepApplications,
 	})
 }
 
 func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_applicationinsights_application")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
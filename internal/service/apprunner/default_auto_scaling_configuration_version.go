@@ -150,6 +150,7 @@ func putDefaultAutoScalingConfiguration(ctx context.Context, conn *apprunner.Cli
 }
 
 type defaultAutoScalingConfigurationVersionResourceModel struct {
+	framework.WithRegionModel
 	AutoScalingConfigurationARN fwtypes.ARN  `tfsdk:"auto_scaling_configuration_arn"`
 	ID                          types.String `tfsdk:"id"`
 }
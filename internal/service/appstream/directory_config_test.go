@@ -32,7 +32,7 @@ func TestAccAppStreamDirectoryConfig_basic(t *testing.T) {
 	rPasswordUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	orgUnitDN := orgUnitFromDomain("Test", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckDirectoryConfigDestroy(ctx),
@@ -85,7 +85,7 @@ func TestAccAppStreamDirectoryConfig_disappears(t *testing.T) {
 	rPassword := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	orgUnitDN := orgUnitFromDomain("Test", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckDirectoryConfigDestroy(ctx),
@@ -114,7 +114,7 @@ func TestAccAppStreamDirectoryConfig_OrganizationalUnitDistinguishedNames(t *tes
 	orgUnitDN1 := orgUnitFromDomain("One", domain)
 	orgUnitDN2 := orgUnitFromDomain("Two", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckDirectoryConfigDestroy(ctx),
@@ -16,29 +16,29 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
 }
 
 func sweepDirectoryConfigs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_appstream_directory_config")
 	if region == names.USWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Directory Config sweep for region: %s", region)
 		return nil
@@ -86,7 +86,7 @@ func sweepDirectoryConfigs(region string) error {
 }
 
 func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_appstream_fleet")
 	if region == names.USWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Fleet sweep for region: %s", region)
 		return nil
@@ -134,7 +134,7 @@ func sweepFleets(region string) error {
 }
 
 func sweepImageBuilders(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_appstream_image_builder")
 	if region == names.USWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Image Builder sweep for region: %s", region)
 		return nil
@@ -182,7 +182,7 @@ func sweepImageBuilders(region string) error {
 }
 
 func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_appstream_stack")
 	if region == names.USWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Stack sweep for region: %s", region)
 		return nil
@@ -27,7 +27,7 @@ func TestAccAppStreamUserStackAssociation_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -61,7 +61,7 @@ func TestAccAppStreamUserStackAssociation_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -90,7 +90,7 @@ func TestAccAppStreamUserStackAssociation_complete(t *testing.T) {
 	rEmail := acctest.RandomEmailAddress(domain)
 	rEmailUpdated := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -27,7 +27,7 @@ func TestAccAppStreamUser_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -63,7 +63,7 @@ func TestAccAppStreamUser_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -93,7 +93,7 @@ func TestAccAppStreamUser_complete(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	rEmail := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -17,7 +17,7 @@ func TestAccAthenaNamedQueryDataSource_basic(t *testing.T) {
 	resourceName := "aws_athena_named_query.test"
 	dataSourceName := "data.aws_athena_named_query.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -24,7 +24,7 @@ func TestAccAthenaPreparedStatement_basic(t *testing.T) {
 	resourceName := "aws_athena_prepared_statement.test"
 	condition := "x = ?"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
@@ -58,7 +58,7 @@ func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
 	resourceName := "aws_athena_prepared_statement.test"
 	condition := "x = ?"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
@@ -86,7 +86,7 @@ func TestAccAthenaPreparedStatement_update(t *testing.T) {
 	condition := "x = ?"
 	updatedCondition := "y = ?"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.AthenaEndpointID)
@@ -423,6 +423,7 @@ var (
 )
 
 type resourceAssessmentData struct {
+	framework.WithRegionModel
 	ARN                          types.String `tfsdk:"arn"`
 	AssessmentReportsDestination types.List   `tfsdk:"assessment_reports_destination"`
 	Description                  types.String `tfsdk:"description"`
@@ -296,6 +296,7 @@ func toID(assessmentID, roleARN, controlSetID string) string {
 }
 
 type resourceAssessmentDelegationData struct {
+	framework.WithRegionModel
 	AssessmentID types.String `tfsdk:"assessment_id"`
 	Comment      types.String `tfsdk:"comment"`
 	ControlSetID types.String `tfsdk:"control_set_id"`
@@ -218,6 +218,7 @@ func FindAssessmentReportByID(ctx context.Context, conn *auditmanager.Client, id
 }
 
 type resourceAssessmentReportData struct {
+	framework.WithRegionModel
 	AssessmentID types.String `tfsdk:"assessment_id"`
 	Author       types.String `tfsdk:"author"`
 	Description  types.String `tfsdk:"description"`
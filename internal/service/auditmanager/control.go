@@ -384,6 +384,7 @@ var (
 )
 
 type resourceControlData struct {
+	framework.WithRegionModel
 	ActionPlanInstructions types.String `tfsdk:"action_plan_instructions"`
 	ActionPlanTitle        types.String `tfsdk:"action_plan_title"`
 	ARN                    types.String `tfsdk:"arn"`
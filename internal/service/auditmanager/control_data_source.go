@@ -167,6 +167,7 @@ func FindControlByName(ctx context.Context, conn *auditmanager.Client, name, con
 }
 
 type dataSourceControlData struct {
+	framework.WithRegionModel
 	ActionPlanInstructions types.String `tfsdk:"action_plan_instructions"`
 	ActionPlanTitle        types.String `tfsdk:"action_plan_title"`
 	ARN                    types.String `tfsdk:"arn"`
@@ -341,6 +341,7 @@ var (
 )
 
 type resourceFrameworkData struct {
+	framework.WithRegionModel
 	ARN            types.String `tfsdk:"arn"`
 	ComplianceType types.String `tfsdk:"compliance_type"`
 	ControlSets    types.Set    `tfsdk:"control_sets"`
@@ -135,6 +135,7 @@ func FindFrameworkByName(ctx context.Context, conn *auditmanager.Client, name, f
 }
 
 type dataSourceFrameworkData struct {
+	framework.WithRegionModel
 	ARN            types.String `tfsdk:"arn"`
 	ComplianceType types.String `tfsdk:"compliance_type"`
 	ControlSets    types.Set    `tfsdk:"control_sets"`
@@ -232,6 +232,7 @@ func CanBeRevoked(status string) bool {
 }
 
 type resourceFrameworkShareData struct {
+	framework.WithRegionModel
 	Comment            types.String `tfsdk:"comment"`
 	DestinationAccount types.String `tfsdk:"destination_account"`
 	DestinationRegion  types.String `tfsdk:"destination_region"`
@@ -140,6 +140,7 @@ func (r *resourceOrganizationAdminAccountRegistration) ImportState(ctx context.C
 }
 
 type resourceOrganizationAdminAccountRegistrationData struct {
+	framework.WithRegionModel
 	AdminAccountID types.String `tfsdk:"admin_account_id"`
 	ID             types.String `tfsdk:"id"`
 	OrganizationID types.String `tfsdk:"organization_id"`
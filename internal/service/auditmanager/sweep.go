@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment",
 		F:    sweepAssessments,
 		Dependencies: []string{
@@ -28,23 +28,23 @@ func RegisterSweepers() {
 			"aws_s3_bucket",
 		},
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_delegation",
 		F:    sweepAssessmentDelegations,
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_report",
 		F:    sweepAssessmentReports,
 	})
-	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
 		Name: "aws_auditmanager_framework_share",
 		F:    sweepFrameworkShares,
 	})
@@ -61,7 +61,7 @@ func isCompleteSetupError(err error) bool {
 }
 
 func sweepAssessments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_assessment")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -101,7 +101,7 @@ func sweepAssessments(region string) error {
 }
 
 func sweepAssessmentDelegations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_assessment_delegation")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -140,7 +140,7 @@ func sweepAssessmentDelegations(region string) error {
 }
 
 func sweepAssessmentReports(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_assessment_report")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -181,7 +181,7 @@ func sweepAssessmentReports(region string) error {
 }
 
 func sweepControls(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_control")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -221,7 +221,7 @@ func sweepControls(region string) error {
 }
 
 func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_framework")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -261,7 +261,7 @@ func sweepFrameworks(region string) error {
 }
 
 func sweepFrameworkShares(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_auditmanager_framework_share")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
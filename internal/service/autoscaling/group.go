@@ -31,6 +31,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -1102,8 +1103,8 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		createInput.ServiceLinkedRoleARN = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tag"); ok {
-		createInput.Tags = Tags(KeyValueTags(ctx, v, asgName, TagResourceTypeGroup).IgnoreAWS())
+	if tags := propagatedGroupTags(ctx, meta.(*conns.AWSClient).DefaultTagsConfig, KeyValueTags(ctx, d.Get("tag"), asgName, TagResourceTypeGroup), asgName); len(tags) > 0 {
+		createInput.Tags = Tags(tags.IgnoreAWS())
 	}
 
 	if v, ok := d.GetOk("target_group_arns"); ok && len(v.(*schema.Set).List()) > 0 {
@@ -1227,6 +1228,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingConn(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	g, err := FindGroupByName(ctx, conn, d.Id())
@@ -1313,7 +1315,9 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.Set("warm_pool_size", g.WarmPoolSize)
 
-	if err := d.Set("tag", ListOfMap(KeyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
+	tags := KeyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags = defaultTagsConfig.RemovePropagatedTags(tags, KeyValueTags(ctx, d.Get("tag"), d.Id(), TagResourceTypeGroup))
+	if err := d.Set("tag", ListOfMap(tags)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
 	}
 
@@ -2768,6 +2772,22 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.AutoScaling, nam
 	return nil, err
 }
 
+// propagatedGroupTags returns the Auto Scaling Group's configured tags merged over any default tags
+// propagated to the instances the group launches.
+func propagatedGroupTags(ctx context.Context, defaultTagsConfig *tftags.DefaultConfig, tags tftags.KeyValueTags, identifier string) tftags.KeyValueTags {
+	var apiObjects []*autoscaling.Tag
+
+	for k, v := range defaultTagsConfig.PropagatedTags(nil).Map() {
+		apiObjects = append(apiObjects, &autoscaling.Tag{
+			Key:               aws.String(k),
+			PropagateAtLaunch: aws.Bool(true),
+			Value:             aws.String(v),
+		})
+	}
+
+	return KeyValueTags(ctx, apiObjects, identifier, TagResourceTypeGroup).Merge(tags)
+}
+
 func expandInstancesDistribution(tfMap map[string]interface{}) *autoscaling.InstancesDistribution {
 	if tfMap == nil {
 		return nil
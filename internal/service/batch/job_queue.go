@@ -339,6 +339,7 @@ func (r *resourceJobQueue) UpgradeState(ctx context.Context) map[int64]resource.
 }
 
 type resourceJobQueueData struct {
+	framework.WithRegionModel
 	ARN                 types.String   `tfsdk:"arn"`
 	ComputeEnvironments types.List     `tfsdk:"compute_environments"`
 	ID                  types.String   `tfsdk:"id"`
@@ -98,6 +98,7 @@ func (d *dataSourceFoundationModel) Read(ctx context.Context, request datasource
 }
 
 type foundationModel struct {
+	framework.WithRegionModel
 	CustomizationsSupported    types.Set    `tfsdk:"customizations_supported"`
 	ID                         types.String `tfsdk:"id"`
 	InferenceTypesSupported    types.Set    `tfsdk:"inference_types_supported"`
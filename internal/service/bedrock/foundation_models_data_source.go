@@ -110,7 +110,7 @@ func (d *dataSourceFoundationModels) Read(ctx context.Context, request datasourc
 	if response.Diagnostics.HasError() {
 		return
 	}
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
 
 	input := &bedrock.ListFoundationModelsInput{}
 	if !data.ByCustomizationType.IsNull() {
@@ -141,6 +141,7 @@ func (d *dataSourceFoundationModels) Read(ctx context.Context, request datasourc
 }
 
 type foundationModels struct {
+	framework.WithRegionModel
 	ByCustomizationType types.String `tfsdk:"by_customization_type"`
 	ByInferenceType     types.String `tfsdk:"by_inference_type"`
 	ByOutputModality    types.String `tfsdk:"by_output_modality"`
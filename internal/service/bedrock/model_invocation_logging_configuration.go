@@ -109,7 +109,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Create(ctx context.Context
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.ID = flex.StringValueToFramework(ctx, r.Meta().Region)
+	data.ID = flex.StringValueToFramework(ctx, r.Meta().RegionForContext(ctx))
 
 	loggingConfig := expandLoggingConfig(ctx, data.LoggingConfig, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -245,6 +245,7 @@ func FindModelInvocationLoggingConfigurationByID(ctx context.Context, conn *bedr
 }
 
 type resourceModelInvocationLoggingConfigurationModel struct {
+	framework.WithRegionModel
 	ID            types.String `tfsdk:"id"`
 	LoggingConfig types.Object `tfsdk:"logging_config"`
 }
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget")
+// @Region(global=true)
 func ResourceBudget() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBudgetCreate,
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget_action")
+// @Region(global=true)
 func ResourceBudgetAction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBudgetActionCreate,
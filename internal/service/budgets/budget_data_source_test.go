@@ -24,7 +24,7 @@ func TestAccBudgetsBudgetDataSource_basic(t *testing.T) {
 	resourceName := "aws_budgets_budget.test"
 	dataSourceName := "data.aws_budgets_budget.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, budgets.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -28,6 +28,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceBudget,
 			TypeName: "aws_budgets_budget",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -37,10 +40,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceBudget,
 			TypeName: "aws_budgets_budget",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceBudgetAction,
 			TypeName: "aws_budgets_budget_action",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
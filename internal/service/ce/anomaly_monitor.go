@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ce_anomaly_monitor", name="Anomaly Monitor")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceAnomalyMonitor() *schema.Resource {
 	return &schema.Resource{
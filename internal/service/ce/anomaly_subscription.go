@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_ce_anomaly_subscription", name="Anomaly Subscription")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceAnomalySubscription() *schema.Resource {
 	return &schema.Resource{
@@ -30,7 +30,7 @@ func TestAccCEAnomalySubscription_basic(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
@@ -66,7 +66,7 @@ func TestAccCEAnomalySubscription_disappears(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
@@ -92,7 +92,7 @@ func TestAccCEAnomalySubscription_Frequency(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
@@ -130,7 +130,7 @@ func TestAccCEAnomalySubscription_MonitorARNList(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
@@ -169,7 +169,7 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	address1 := acctest.RandomEmailAddress(domain)
 	address2 := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
@@ -236,7 +236,7 @@ func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	domain := acctest.RandomDomainName()
 	address := acctest.RandomEmailAddress(domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
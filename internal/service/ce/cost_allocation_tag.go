@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_ce_cost_allocation_tag")
+// @Region(global=true)
 func ResourceCostAllocationTag() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCostAllocationTagUpdate,
@@ -25,7 +25,7 @@ func TestAccCECostAllocationTag_basic(t *testing.T) {
 	resourceName := "aws_ce_cost_allocation_tag.test"
 	rName := "Tag01"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             nil,
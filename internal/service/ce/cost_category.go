@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_ce_cost_category", name="Cost Category")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceCostCategory() *schema.Resource {
 	return &schema.Resource{
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_ce_cost_category")
+// @Region(global=true)
 func DataSourceCostCategory() *schema.Resource {
 	schemaCostCategoryRuleExpressionComputed := func() *schema.Resource {
 		return &schema.Resource{
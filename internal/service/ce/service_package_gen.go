@@ -28,10 +28,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceCostCategory,
 			TypeName: "aws_ce_cost_category",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceTags,
 			TypeName: "aws_ce_tags",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -42,6 +48,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAnomalyMonitor,
 			TypeName: "aws_ce_anomaly_monitor",
 			Name:     "Anomaly Monitor",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -50,6 +59,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAnomalySubscription,
 			TypeName: "aws_ce_anomaly_subscription",
 			Name:     "Anomaly Subscription",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -57,11 +69,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceCostAllocationTag,
 			TypeName: "aws_ce_cost_allocation_tag",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceCostCategory,
 			TypeName: "aws_ce_cost_category",
 			Name:     "Cost Category",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
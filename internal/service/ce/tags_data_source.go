@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_ce_tags")
+// @Region(global=true)
 func DataSourceTags() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTagsRead,
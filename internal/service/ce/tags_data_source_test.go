@@ -27,7 +27,7 @@ func TestAccCETagsDataSource_basic(t *testing.T) {
 	startDate := monthsAgo.Format(formatDate)
 	endDate := currentTime.Format(formatDate)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
@@ -56,7 +56,7 @@ func TestAccCETagsDataSource_filter(t *testing.T) {
 	startDate := monthsAgo.Format(formatDate)
 	endDate := currentTime.Format(formatDate)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
@@ -5,7 +5,6 @@ package cloudcontrol
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -25,9 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/mattbaird/jsonpatch"
 )
 
 // @SDKResource("aws_cloudcontrolapi_resource")
@@ -78,9 +75,6 @@ func ResourceResource() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceResourceCustomizeDiffGetSchema,
 			resourceResourceCustomizeDiffSchemaDiff,
-			customdiff.ComputedIf("properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("desired_state")
-			}),
 		),
 	}
 }
@@ -162,14 +156,30 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
 
 	if d.HasChange("desired_state") {
+		var cfResource *cfschema.Resource
+
+		if v := d.Get("schema").(string); v != "" {
+			var err error
+			_, cfResource, err = parseResourceSchema(v)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		patchDocument, err := resourcePatchDocument(cfResource, oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
 		}
 
+		// Nothing to update if only read-only or write-only properties were removed.
+		if patchDocument == "[]" {
+			return append(diags, resourceResourceRead(ctx, d, meta)...)
+		}
+
 		typeName := d.Get("type_name").(string)
 		input := &cloudcontrol.UpdateResourceInput{
 			ClientToken:   aws.String(id.UniqueId()),
@@ -251,13 +261,13 @@ func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.Re
 
 	typeName := diff.Get("type_name").(string)
 
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+	resourceSchema, err := findResourceSchemaByTypeName(ctx, conn, typeName)
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
 	}
 
-	if err := diff.SetNew("schema", output.Schema); err != nil {
+	if err := diff.SetNew("schema", resourceSchema); err != nil {
 		return fmt.Errorf("setting schema New: %w", err)
 	}
 
@@ -276,19 +286,19 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 
 	// desired_state can be empty if unknown
 	if newDesiredState == "" {
-		return nil
-	}
-
-	newSchema, err := cfschema.Sanitize(newSchema)
+		if diff.Id() != "" {
+			if err := diff.SetNewComputed("properties"); err != nil {
+				return fmt.Errorf("setting properties NewComputed: %w", err)
+			}
+		}
 
-	if err != nil {
-		return fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+		return nil
 	}
 
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
+	cfResourceSchema, cfResource, err := parseResourceSchema(newSchema)
 
 	if err != nil {
-		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
 	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
@@ -300,25 +310,25 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
+	desiredStateDiff, err := diffDesiredState(cfResource, oldDesiredStateRaw.(string), newDesiredState)
 
 	if err != nil {
-		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	if desiredStateDiff.RequiresReplacement {
+		if err := diff.ForceNew("desired_state"); err != nil {
+			return fmt.Errorf("setting desired_state ForceNew: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+		return nil
 	}
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("setting desired_state ForceNew: %w", err)
-			}
-
-			break
+	// Write-only properties are not returned by Cloud Control API, so changing only them does not change properties.
+	// Otherwise read-only property values are not known until after the update.
+	if !desiredStateDiff.WriteOnly {
+		if err := diff.SetNewComputed("properties"); err != nil {
+			return fmt.Errorf("setting properties NewComputed: %w", err)
 		}
 	}
 
@@ -427,20 +437,3 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 
 	return nil, err
 }
-
-// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
-
-	if err != nil {
-		return "", err
-	}
-
-	b, err := json.Marshal(patch)
-
-	if err != nil {
-		return "", err
-	}
-
-	return string(b), nil
-}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/mattbaird/jsonpatch"
+)
+
+const (
+	jsonPointerWildcard = "*"
+)
+
+// resourceSchemaCache caches CloudFormation registry schemas by type name,
+// so that planning multiple resources of the same type reads the schema only once.
+var resourceSchemaCache = struct {
+	sync.Mutex
+	schemas map[string]string
+}{
+	schemas: make(map[string]string),
+}
+
+// findResourceSchemaByTypeName returns the CloudFormation registry schema for the specified type,
+// reading it from the registry only if it has not been read before.
+func findResourceSchemaByTypeName(ctx context.Context, conn *cloudformation.CloudFormation, typeName string) (string, error) {
+	resourceSchemaCache.Lock()
+	defer resourceSchemaCache.Unlock()
+
+	if v, ok := resourceSchemaCache.schemas[typeName]; ok {
+		return v, nil
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+
+	if err != nil {
+		return "", err
+	}
+
+	v := aws.StringValue(output.Schema)
+	resourceSchemaCache.schemas[typeName] = v
+
+	return v, nil
+}
+
+// parseResourceSchema parses a CloudFormation registry schema.
+func parseResourceSchema(resourceSchema string) (*cfschema.ResourceJsonSchema, *cfschema.Resource, error) {
+	resourceSchema, err := cfschema.Sanitize(resourceSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	jsonSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := jsonSchema.Resource()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return jsonSchema, resource, nil
+}
+
+// desiredStateDiff describes the effect of a change to a resource's desired state.
+type desiredStateDiff struct {
+	// RequiresReplacement is true if a create-only property's value changed.
+	RequiresReplacement bool
+	// WriteOnly is true if only write-only property values changed.
+	// Write-only properties are never returned by Cloud Control API, so such changes do not affect the resource's properties.
+	WriteOnly bool
+}
+
+// diffDesiredState returns the effect of changing a resource's desired state from old to new.
+func diffDesiredState(resource *cfschema.Resource, old, new string) (*desiredStateDiff, error) {
+	oldValue, err := decodeJSON(old)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding old desired_state: %w", err)
+	}
+
+	newValue, err := decodeJSON(new)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding new desired_state: %w", err)
+	}
+
+	diff := &desiredStateDiff{}
+
+	for _, ptr := range resource.CreateOnlyProperties {
+		path := ptr.Path()
+
+		if !reflect.DeepEqual(valueAtPath(oldValue, path), valueAtPath(newValue, path)) {
+			diff.RequiresReplacement = true
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) && len(resource.WriteOnlyProperties) > 0 {
+		for _, ptr := range resource.WriteOnlyProperties {
+			path := ptr.Path()
+			oldValue = removeAtPath(oldValue, path)
+			newValue = removeAtPath(newValue, path)
+		}
+
+		diff.WriteOnly = reflect.DeepEqual(oldValue, newValue)
+	}
+
+	return diff, nil
+}
+
+// resourcePatchDocument returns a JSON Patch document describing the difference between the old and new desired state.
+// Read-only properties are never patched.
+// Write-only properties are not returned by Cloud Control API, so the current resource state has no value to replace or remove:
+// replacements become additions and removals are dropped.
+func resourcePatchDocument(resource *cfschema.Resource, old, new string) (string, error) {
+	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+
+	if err != nil {
+		return "", err
+	}
+
+	operations := make([]jsonpatch.JsonPatchOperation, 0, len(patch))
+
+	for _, operation := range patch {
+		path := jsonPatchPath(operation.Path)
+
+		if resource != nil && pointersCoverPath(resource.ReadOnlyProperties, path) {
+			continue
+		}
+
+		if resource != nil && pointersCoverPath(resource.WriteOnlyProperties, path) {
+			switch operation.Operation {
+			case "remove":
+				continue
+			case "replace":
+				operation.Operation = "add"
+			}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	b, err := json.Marshal(operations)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func decodeJSON(s string) (any, error) {
+	var v any
+
+	if s == "" {
+		return v, nil
+	}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// jsonPatchPath returns the reference tokens of a JSON Patch operation's path.
+func jsonPatchPath(path string) []string {
+	tokens := strings.Split(strings.TrimPrefix(path, cfschema.JsonPointerReferenceTokenSeparator), cfschema.JsonPointerReferenceTokenSeparator)
+
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens
+}
+
+// pointersCoverPath returns whether the path is at, or below, any of the property JSON pointers.
+// A "*" reference token in a property JSON pointer matches any array index.
+func pointersCoverPath(ptrs cfschema.PropertyJsonPointers, path []string) bool {
+	for _, ptr := range ptrs {
+		ptrPath := ptr.Path()
+
+		if len(ptrPath) > len(path) {
+			continue
+		}
+
+		covered := true
+
+		for i, token := range ptrPath {
+			if token != jsonPointerWildcard && token != path[i] {
+				covered = false
+				break
+			}
+		}
+
+		if covered {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueAtPath returns the values at a property JSON pointer path.
+// A "*" reference token returns the values for all array elements.
+func valueAtPath(v any, path []string) any {
+	if len(path) == 0 {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		return valueAtPath(v[path[0]], path[1:])
+	case []any:
+		if path[0] == jsonPointerWildcard {
+			values := make([]any, len(v))
+
+			for i, e := range v {
+				values[i] = valueAtPath(e, path[1:])
+			}
+
+			return values
+		}
+
+		if i, err := strconv.Atoi(path[0]); err == nil && i >= 0 && i < len(v) {
+			return valueAtPath(v[i], path[1:])
+		}
+	}
+
+	return nil
+}
+
+// removeAtPath returns a copy of v with the values at a property JSON pointer path removed.
+func removeAtPath(v any, path []string) any {
+	if len(path) == 0 {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+
+		for k, e := range v {
+			if k != path[0] {
+				m[k] = e
+			} else if len(path) > 1 {
+				m[k] = removeAtPath(e, path[1:])
+			}
+		}
+
+		return m
+	case []any:
+		s := make([]any, len(v))
+
+		for i, e := range v {
+			if path[0] == jsonPointerWildcard || path[0] == strconv.Itoa(i) {
+				s[i] = removeAtPath(e, path[1:])
+			} else {
+				s[i] = e
+			}
+		}
+
+		return s
+	}
+
+	return v
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+)
+
+func TestDiffDesiredState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaFile                  string
+		old                         string
+		new                         string
+		expectedRequiresReplacement bool
+		expectedWriteOnly           bool
+	}{
+		"no change": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Password":"p1"}`,
+			new:        `{"Name":"a","Password":"p1"}`,
+		},
+		"updatable property": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Description":"d1"}`,
+			new:        `{"Name":"a","Description":"d2"}`,
+		},
+		"create-only property": {
+			schemaFile:                  "Example_Test_Widget.json",
+			old:                         `{"Name":"a"}`,
+			new:                         `{"Name":"b"}`,
+			expectedRequiresReplacement: true,
+		},
+		"create-only property in array": {
+			schemaFile:                  "Example_Test_Widget.json",
+			old:                         `{"Name":"a","Rules":[{"Id":"r1"}]}`,
+			new:                         `{"Name":"a","Rules":[{"Id":"r2"}]}`,
+			expectedRequiresReplacement: true,
+		},
+		"create-only property added in array": {
+			schemaFile:                  "Example_Test_Widget.json",
+			old:                         `{"Name":"a","Rules":[{"Id":"r1"}]}`,
+			new:                         `{"Name":"a","Rules":[{"Id":"r1"},{"Id":"r2"}]}`,
+			expectedRequiresReplacement: true,
+		},
+		"write-only property": {
+			schemaFile:        "Example_Test_Widget.json",
+			old:               `{"Name":"a","Password":"p1"}`,
+			new:               `{"Name":"a","Password":"p2"}`,
+			expectedWriteOnly: true,
+		},
+		"nested write-only property": {
+			schemaFile:        "Example_Test_Widget.json",
+			old:               `{"Name":"a","Settings":{"Mode":"m","Secret":"s1"}}`,
+			new:               `{"Name":"a","Settings":{"Mode":"m","Secret":"s2"}}`,
+			expectedWriteOnly: true,
+		},
+		"write-only property in array": {
+			schemaFile:        "Example_Test_Widget.json",
+			old:               `{"Name":"a","Rules":[{"Id":"r1","Token":"t1"}]}`,
+			new:               `{"Name":"a","Rules":[{"Id":"r1","Token":"t2"}]}`,
+			expectedWriteOnly: true,
+		},
+		"write-only and updatable properties": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Description":"d1","Password":"p1"}`,
+			new:        `{"Name":"a","Description":"d2","Password":"p2"}`,
+		},
+		"no write-only properties": {
+			schemaFile: "Example_Test_LogGroup.json",
+			old:        `{"LogGroupName":"a","RetentionInDays":1}`,
+			new:        `{"LogGroupName":"a","RetentionInDays":7}`,
+		},
+		"no write-only properties create-only property": {
+			schemaFile:                  "Example_Test_LogGroup.json",
+			old:                         `{"LogGroupName":"a","RetentionInDays":1}`,
+			new:                         `{"LogGroupName":"b","RetentionInDays":1}`,
+			expectedRequiresReplacement: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource := testResourceSchema(t, testCase.schemaFile)
+
+			got, err := tfcloudcontrol.DiffDesiredState(resource, testCase.old, testCase.new)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.RequiresReplacement != testCase.expectedRequiresReplacement {
+				t.Errorf("RequiresReplacement = %t, want %t", got.RequiresReplacement, testCase.expectedRequiresReplacement)
+			}
+			if got.WriteOnly != testCase.expectedWriteOnly {
+				t.Errorf("WriteOnly = %t, want %t", got.WriteOnly, testCase.expectedWriteOnly)
+			}
+		})
+	}
+}
+
+func TestResourcePatchDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaFile string
+		old        string
+		new        string
+		expected   []map[string]any
+	}{
+		"no schema": {
+			old: `{"Name":"a","Password":"p1"}`,
+			new: `{"Name":"a","Password":"p2"}`,
+			expected: []map[string]any{
+				{"op": "replace", "path": "/Password", "value": "p2"},
+			},
+		},
+		"updatable property": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Description":"d1"}`,
+			new:        `{"Name":"a","Description":"d2"}`,
+			expected: []map[string]any{
+				{"op": "replace", "path": "/Description", "value": "d2"},
+			},
+		},
+		"write-only property replaced": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Password":"p1"}`,
+			new:        `{"Name":"a","Password":"p2"}`,
+			expected: []map[string]any{
+				{"op": "add", "path": "/Password", "value": "p2"},
+			},
+		},
+		"write-only property removed": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Password":"p1"}`,
+			new:        `{"Name":"a"}`,
+			expected:   []map[string]any{},
+		},
+		"write-only property in array replaced": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Rules":[{"Id":"r1","Token":"t1"}]}`,
+			new:        `{"Name":"a","Rules":[{"Id":"r1","Token":"t2"}]}`,
+			expected: []map[string]any{
+				{"op": "add", "path": "/Rules/0/Token", "value": "t2"},
+			},
+		},
+		"read-only property": {
+			schemaFile: "Example_Test_Widget.json",
+			old:        `{"Name":"a","Arn":"arn1"}`,
+			new:        `{"Name":"a","Arn":"arn2"}`,
+			expected:   []map[string]any{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var resource *cfschema.Resource
+			if testCase.schemaFile != "" {
+				resource = testResourceSchema(t, testCase.schemaFile)
+			}
+
+			got, err := tfcloudcontrol.ResourcePatchDocument(resource, testCase.old, testCase.new)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var operations []map[string]any
+			if err := json.Unmarshal([]byte(got), &operations); err != nil {
+				t.Fatalf("decoding patch document: %s", err)
+			}
+
+			if diff := cmp.Diff(operations, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	resource := testResourceSchema(t, "Example_Test_Widget.json")
+
+	if got, want := len(resource.CreateOnlyProperties), 2; got != want {
+		t.Errorf("%d create-only properties, want %d", got, want)
+	}
+	if got, want := len(resource.ReadOnlyProperties), 1; got != want {
+		t.Errorf("%d read-only properties, want %d", got, want)
+	}
+	if got, want := len(resource.WriteOnlyProperties), 3; got != want {
+		t.Errorf("%d write-only properties, want %d", got, want)
+	}
+
+	if _, _, err := tfcloudcontrol.ParseResourceSchema(`{`); err == nil {
+		t.Error("expected error for invalid schema")
+	}
+}
+
+func testResourceSchema(t *testing.T, name string) *cfschema.Resource {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+
+	if err != nil {
+		t.Fatalf("reading %s: %s", name, err)
+	}
+
+	_, resource, err := tfcloudcontrol.ParseResourceSchema(string(b))
+
+	if err != nil {
+		t.Fatalf("parsing %s: %s", name, err)
+	}
+
+	return resource
+}
@@ -42,7 +42,7 @@ func dataSourceExportRead(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
 	var value string
 	name := d.Get("name").(string)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 	d.SetId(fmt.Sprintf("cloudformation-exports-%s-%s", region, name))
 	input := &cloudformation.ListExportsInput{}
 	err := conn.ListExportsPagesWithContext(ctx, input,
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+// Exports for use in tests only.
+var (
+	DisallowedStackReplacements = disallowedStackReplacements
+	StackReplacements           = stackReplacements
+)
+
+type (
+	StackReplacement = stackReplacement
+)
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_cloudformation_stack", name="Stack")
-// @Tags
+// @Tags(tagPolicyResourceType="cloudformation:stack")
 func ResourceStack() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStackCreate,
@@ -50,6 +50,11 @@ func ResourceStack() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allowed_replacements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"capabilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -59,11 +64,23 @@ func ResourceStack() *schema.Resource {
 				},
 				Set: schema.HashString,
 			},
+			"change_set_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -96,6 +113,30 @@ func ResourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_replacements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -110,6 +151,10 @@ func ResourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"prevent_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -136,6 +181,7 @@ func ResourceStack() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			resourceStackCustomizeDiffChangeSet,
 		),
 	}
 }
@@ -256,6 +302,18 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 			d.Set("disable_rollback", false)
 		}
 	}
+	driftStatus := ""
+	if stack.DriftInformation != nil {
+		driftStatus = aws.StringValue(stack.DriftInformation.StackDriftStatus)
+	}
+	if d.Get("detect_drift").(bool) {
+		driftStatus, err = detectStackDrift(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detecting CloudFormation Stack (%s) drift: %s", d.Id(), err)
+		}
+	}
+	d.Set("drift_status", driftStatus)
 	d.Set("iam_role_arn", stack.RoleARN)
 	d.Set("name", stack.StackName)
 	if len(stack.NotificationARNs) > 0 {
@@ -267,6 +325,8 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("parameters", flattenParameters(stack.Parameters, d.Get("parameters").(map[string]interface{}))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
+	// Planned replacements only describe a pending change, so are cleared once it's applied or when there is none.
+	d.Set("planned_replacements", nil)
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
 
 	setTagsOut(ctx, stack.Tags)
@@ -278,6 +338,14 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
 
+	if d.Get("change_set_preview").(bool) {
+		if err := updateStackWithChangeSet(ctx, conn, d, getTagsIn(ctx)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+		}
+
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
@@ -707,6 +775,9 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.Computed && !attr.Optional {
 			continue
 		}
+		if isStackTerraformOnlyAttribute(k) {
+			continue
+		}
 
 		if d.HasChange(k) {
 			if attr.StateFunc == nil {
@@ -722,3 +793,39 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// resourceStackCustomizeDiffChangeSet previews an update to an existing stack by creating a change set.
+// The resources that the change set replaces are planned as planned_replacements.
+func resourceStackCustomizeDiffChangeSet(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get("change_set_preview").(bool) || !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	// The change set can't be created until all the stack's arguments are known.
+	for _, key := range []string{"capabilities", "iam_role_arn", "notification_arns", "parameters", names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("planned_replacements")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	tags := tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{})).IgnoreAWS()
+	input, err := expandStackChangeSetInput(d, Tags(tags))
+
+	if err != nil {
+		return err
+	}
+
+	replacements, err := previewStackChangeSet(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetNew("planned_replacements", flattenStackReplacements(replacements)); err != nil {
+		return fmt.Errorf("setting planned_replacements: %w", err)
+	}
+
+	return checkStackReplacements(d, replacements)
+}
@@ -0,0 +1,429 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	stackDriftDetectionTimeout = 10 * time.Minute
+)
+
+// stackTerraformOnlyAttributes are arguments that configure the resource's behavior, not the stack.
+var stackTerraformOnlyAttributes = []string{
+	"allowed_replacements",
+	"change_set_preview",
+	"detect_drift",
+	"prevent_replacement",
+}
+
+func isStackTerraformOnlyAttribute(k string) bool {
+	return slices.Contains(stackTerraformOnlyAttributes, k)
+}
+
+// stackReplacement is a resource that a change set replaces.
+type stackReplacement struct {
+	LogicalResourceID  string
+	PhysicalResourceID string
+	Replacement        string
+	ResourceType       string
+}
+
+// expandStackChangeSetInput returns the input for a change set that updates the stack to the configured state.
+func expandStackChangeSetInput(d verify.ResourceDiffer, tags []*cloudformation.Tag) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(id.UniqueId()),
+		ChangeSetType: aws.String(cloudformation.ChangeSetTypeUpdate),
+		StackName:     aws.String(d.Id()),
+		Tags:          tags,
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	} else if v, ok := d.GetOk("template_body"); ok {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, fmt.Errorf("template body contains an invalid JSON or YAML: %w", err)
+		}
+		input.TemplateBody = aws.String(template)
+	} else {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
+
+	return input, nil
+}
+
+// createStackChangeSet creates a change set and waits for it to be created.
+// The returned output contains all the change set's changes.
+// If the change set contains no changes it is deleted and nil is returned.
+func createStackChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	stackID, changeSetName := aws.StringValue(input.StackName), aws.StringValue(input.ChangeSetName)
+
+	if _, err := conn.CreateChangeSetWithContext(ctx, input); err != nil {
+		return nil, fmt.Errorf("creating CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetName, err)
+	}
+
+	output, err := WaitChangeSetCreated(ctx, conn, stackID, changeSetName)
+
+	if output != nil && aws.StringValue(output.Status) == cloudformation.ChangeSetStatusFailed && isChangeSetEmpty(output) {
+		if err := deleteStackChangeSet(ctx, conn, stackID, changeSetName); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	if err != nil {
+		err = fmt.Errorf("waiting for CloudFormation Stack (%s) Change Set (%s) create: %w", stackID, changeSetName, err)
+
+		return nil, errors.Join(err, deleteStackChangeSet(ctx, conn, stackID, changeSetName))
+	}
+
+	changes, err := findChangeSetChanges(ctx, conn, stackID, changeSetName)
+
+	if err != nil {
+		err = fmt.Errorf("reading CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetName, err)
+
+		return nil, errors.Join(err, deleteStackChangeSet(ctx, conn, stackID, changeSetName))
+	}
+
+	output.Changes = changes
+	output.NextToken = nil
+
+	return output, nil
+}
+
+func deleteStackChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) error {
+	_, err := conn.DeleteChangeSetWithContext(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetName, err)
+	}
+
+	return nil
+}
+
+// isChangeSetEmpty returns whether a change set failed because it contains no changes.
+func isChangeSetEmpty(output *cloudformation.DescribeChangeSetOutput) bool {
+	reason := aws.StringValue(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+func findChangeSetChanges(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) ([]*cloudformation.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []*cloudformation.Change
+
+	for {
+		page, err := conn.DescribeChangeSetWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		output = append(output, page.Changes...)
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// previewStackChangeSet returns the resources that updating the stack to the configured state would replace.
+// The change set is deleted without being executed.
+func previewStackChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput) ([]stackReplacement, error) {
+	output, err := createStackChangeSet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	if err := deleteStackChangeSet(ctx, conn, aws.StringValue(output.StackId), aws.StringValue(output.ChangeSetId)); err != nil {
+		return nil, err
+	}
+
+	return stackReplacements(output.Changes), nil
+}
+
+// updateStackWithChangeSet updates the stack to the configured state by creating and executing a change set.
+// The change set is not executed if it replaces resources that are not allowed to be replaced.
+func updateStackWithChangeSet(ctx context.Context, conn *cloudformation.CloudFormation, d *schema.ResourceData, tags []*cloudformation.Tag) error {
+	input, err := expandStackChangeSetInput(d, tags)
+
+	if err != nil {
+		return err
+	}
+
+	output, err := createStackChangeSet(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil {
+		stackID, changeSetID := aws.StringValue(output.StackId), aws.StringValue(output.ChangeSetId)
+		replacements := stackReplacements(output.Changes)
+
+		if err := d.Set("planned_replacements", flattenStackReplacements(replacements)); err != nil {
+			return fmt.Errorf("setting planned_replacements: %w", err)
+		}
+
+		if err := checkStackReplacements(d, replacements); err != nil {
+			return errors.Join(err, deleteStackChangeSet(ctx, conn, stackID, changeSetID))
+		}
+
+		requestToken := id.UniqueId()
+		_, err := conn.ExecuteChangeSetWithContext(ctx, &cloudformation.ExecuteChangeSetInput{
+			ChangeSetName:      aws.String(changeSetID),
+			ClientRequestToken: aws.String(requestToken),
+			StackName:          aws.String(stackID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("executing CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetID, err)
+		}
+
+		if _, err := WaitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for CloudFormation Stack (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	// Change sets cannot update the stack policy.
+	if d.HasChanges("policy_body", "policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_body"); ok {
+			policy, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return fmt.Errorf("policy body contains an invalid JSON: %w", err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		}
+		if v, ok := d.GetOk("policy_url"); ok {
+			input.StackPolicyURL = aws.String(v.(string))
+		}
+
+		if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+			if _, err := conn.SetStackPolicyWithContext(ctx, input); err != nil {
+				return fmt.Errorf("setting CloudFormation Stack (%s) policy: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkStackReplacements returns an error if replacement is prevented and any resource not explicitly allowed is replaced.
+func checkStackReplacements(d verify.ResourceDiffer, replacements []stackReplacement) error {
+	if !d.Get("prevent_replacement").(bool) {
+		return nil
+	}
+
+	allowed := flex.ExpandStringValueSet(d.Get("allowed_replacements").(*schema.Set))
+
+	if disallowed := disallowedStackReplacements(replacements, allowed); len(disallowed) > 0 {
+		return fmt.Errorf("CloudFormation Stack (%s) change set replaces resources that are not in allowed_replacements: %s", d.Id(), strings.Join(disallowed, ", "))
+	}
+
+	return nil
+}
+
+// stackReplacements returns the resources that a change set's changes replace, or may replace.
+func stackReplacements(changes []*cloudformation.Change) []stackReplacement {
+	var replacements []stackReplacement
+
+	for _, change := range changes {
+		if change == nil || change.ResourceChange == nil {
+			continue
+		}
+
+		rc := change.ResourceChange
+
+		if aws.StringValue(rc.Action) != cloudformation.ChangeActionModify {
+			continue
+		}
+
+		switch replacement := aws.StringValue(rc.Replacement); replacement {
+		case cloudformation.ReplacementTrue, cloudformation.ReplacementConditional:
+			replacements = append(replacements, stackReplacement{
+				LogicalResourceID:  aws.StringValue(rc.LogicalResourceId),
+				PhysicalResourceID: aws.StringValue(rc.PhysicalResourceId),
+				Replacement:        replacement,
+				ResourceType:       aws.StringValue(rc.ResourceType),
+			})
+		}
+	}
+
+	slices.SortFunc(replacements, func(a, b stackReplacement) int {
+		return strings.Compare(a.LogicalResourceID, b.LogicalResourceID)
+	})
+
+	return replacements
+}
+
+// disallowedStackReplacements returns the logical IDs of replaced resources that are not allowed to be replaced.
+func disallowedStackReplacements(replacements []stackReplacement, allowed []string) []string {
+	var disallowed []string
+
+	for _, replacement := range replacements {
+		if !slices.Contains(allowed, replacement.LogicalResourceID) {
+			disallowed = append(disallowed, replacement.LogicalResourceID)
+		}
+	}
+
+	return disallowed
+}
+
+func flattenStackReplacements(replacements []stackReplacement) []interface{} {
+	tfList := make([]interface{}, 0, len(replacements))
+
+	for _, replacement := range replacements {
+		tfList = append(tfList, map[string]interface{}{
+			"logical_resource_id":  replacement.LogicalResourceID,
+			"physical_resource_id": replacement.PhysicalResourceID,
+			"replacement":          replacement.Replacement,
+			"resource_type":        replacement.ResourceType,
+		})
+	}
+
+	return tfList
+}
+
+// detectStackDrift detects drift on the stack's resources and returns the stack's drift status.
+func detectStackDrift(ctx context.Context, conn *cloudformation.CloudFormation, stackID string) (string, error) {
+	output, err := conn.DetectStackDriftWithContext(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	status, err := waitStackDriftDetectionComplete(ctx, conn, aws.StringValue(output.StackDriftDetectionId), stackDriftDetectionTimeout)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for drift detection (%s): %w", aws.StringValue(output.StackDriftDetectionId), err)
+	}
+
+	// Drift detection fails for stacks containing resources that do not support drift detection,
+	// but the stack's drift status is still reported.
+	if aws.StringValue(status.DetectionStatus) == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		log.Printf("[WARN] CloudFormation Stack (%s) drift detection failed: %s", stackID, aws.StringValue(status.DetectionStatusReason))
+	}
+
+	return aws.StringValue(status.StackDriftStatus), nil
+}
+
+func findStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.CloudFormation, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatusWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStackDriftDetection(ctx context.Context, conn *cloudformation.CloudFormation, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findStackDriftDetectionStatusByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectionStatus), nil
+	}
+}
+
+func waitStackDriftDetectionComplete(ctx context.Context, conn *cloudformation.CloudFormation, id string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cloudformation.StackDriftDetectionStatusDetectionInProgress},
+		Target:  []string{cloudformation.StackDriftDetectionStatusDetectionComplete, cloudformation.StackDriftDetectionStatusDetectionFailed},
+		Refresh: statusStackDriftDetection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/google/go-cmp/cmp"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+)
+
+func TestStackReplacements(t *testing.T) {
+	t.Parallel()
+
+	resourceChange := func(action, logicalID, replacement string) *cloudformation.Change {
+		return &cloudformation.Change{
+			Type: aws.String(cloudformation.ChangeTypeResource),
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:             aws.String(action),
+				LogicalResourceId:  aws.String(logicalID),
+				PhysicalResourceId: aws.String(logicalID + "-physical"),
+				Replacement:        aws.String(replacement),
+				ResourceType:       aws.String("AWS::SNS::Topic"),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		changes  []*cloudformation.Change
+		expected []tfcloudformation.StackReplacement
+	}{
+		"no changes": {},
+		"no replacements": {
+			changes: []*cloudformation.Change{
+				resourceChange(cloudformation.ChangeActionModify, "Topic", cloudformation.ReplacementFalse),
+				resourceChange(cloudformation.ChangeActionAdd, "Queue", ""),
+				resourceChange(cloudformation.ChangeActionRemove, "Bucket", ""),
+				{Type: aws.String(cloudformation.ChangeTypeResource)},
+			},
+		},
+		"replacements": {
+			changes: []*cloudformation.Change{
+				resourceChange(cloudformation.ChangeActionModify, "TopicB", cloudformation.ReplacementConditional),
+				resourceChange(cloudformation.ChangeActionModify, "TopicC", cloudformation.ReplacementFalse),
+				resourceChange(cloudformation.ChangeActionModify, "TopicA", cloudformation.ReplacementTrue),
+			},
+			expected: []tfcloudformation.StackReplacement{
+				{
+					LogicalResourceID:  "TopicA",
+					PhysicalResourceID: "TopicA-physical",
+					Replacement:        cloudformation.ReplacementTrue,
+					ResourceType:       "AWS::SNS::Topic",
+				},
+				{
+					LogicalResourceID:  "TopicB",
+					PhysicalResourceID: "TopicB-physical",
+					Replacement:        cloudformation.ReplacementConditional,
+					ResourceType:       "AWS::SNS::Topic",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudformation.StackReplacements(testCase.changes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDisallowedStackReplacements(t *testing.T) {
+	t.Parallel()
+
+	replacements := []tfcloudformation.StackReplacement{
+		{LogicalResourceID: "TopicA"},
+		{LogicalResourceID: "TopicB"},
+	}
+
+	testCases := map[string]struct {
+		allowed  []string
+		expected []string
+	}{
+		"none allowed": {
+			expected: []string{"TopicA", "TopicB"},
+		},
+		"some allowed": {
+			allowed:  []string{"TopicB", "Queue"},
+			expected: []string{"TopicA"},
+		},
+		"all allowed": {
+			allowed: []string{"TopicA", "TopicB"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudformation.DisallowedStackReplacements(replacements, testCase.allowed)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
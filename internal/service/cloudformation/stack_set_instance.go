@@ -189,7 +189,7 @@ func resourceStackSetInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
 
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
 	}
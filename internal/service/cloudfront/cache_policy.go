@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_cache_policy")
+// @Region(global=true)
 func ResourceCachePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCachePolicyCreate,
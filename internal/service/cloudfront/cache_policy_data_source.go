@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_cache_policy")
+// @Region(global=true)
 func DataSourceCachePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCachePolicyRead,
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource(name="Continuous Deployment Policy")
+// @Region(global=true)
 func newResourceContinuousDeploymentPolicy(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceContinuousDeploymentPolicy{}, nil
 }
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_distribution", name="Distribution")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceDistribution() *schema.Resource {
 	//lintignore:R011
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_distribution")
+// @Region(global=true)
 func DataSourceDistribution() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDistributionRead,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_field_level_encryption_config")
+// @Region(global=true)
 func ResourceFieldLevelEncryptionConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFieldLevelEncryptionConfigCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_field_level_encryption_profile")
+// @Region(global=true)
 func ResourceFieldLevelEncryptionProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFieldLevelEncryptionProfileCreate,
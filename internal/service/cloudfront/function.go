@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_function")
+// @Region(global=true)
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
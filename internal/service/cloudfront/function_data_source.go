@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_function")
+// @Region(global=true)
 func DataSourceFunction() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceFunctionRead,
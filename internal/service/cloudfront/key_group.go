@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_key_group")
+// @Region(global=true)
 func ResourceKeyGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyGroupCreate,
@@ -21,6 +21,7 @@ const (
 )
 
 // @SDKDataSource("aws_cloudfront_log_delivery_canonical_user_id")
+// @Region(global=true)
 func DataSourceLogDeliveryCanonicalUserID() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceLogDeliveryCanonicalUserIDRead,
@@ -38,7 +39,7 @@ func dataSourceLogDeliveryCanonicalUserIDRead(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	canonicalId := defaultLogDeliveryCanonicalUserID
 
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
 	}
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_monitoring_subscription")
+// @Region(global=true)
 func ResourceMonitoringSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMonitoringSubscriptionCreate,
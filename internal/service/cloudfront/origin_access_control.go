@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_access_control")
+// @Region(global=true)
 func ResourceOriginAccessControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginAccessControlCreate,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_access_identities")
+// @Region(global=true)
 func DataSourceOriginAccessIdentities() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginAccessIdentitiesRead,
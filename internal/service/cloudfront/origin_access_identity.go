@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_access_identity")
+// @Region(global=true)
 func ResourceOriginAccessIdentity() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginAccessIdentityCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_access_identity")
+// @Region(global=true)
 func DataSourceOriginAccessIdentity() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginAccessIdentityRead,
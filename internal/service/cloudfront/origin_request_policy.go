@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_request_policy")
+// @Region(global=true)
 func ResourceOriginRequestPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginRequestPolicyCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_request_policy")
+// @Region(global=true)
 func DataSourceOriginRequestPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginRequestPolicyRead,
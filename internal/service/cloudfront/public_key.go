@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_public_key")
+// @Region(global=true)
 func ResourcePublicKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePublicKeyCreate,
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_realtime_log_config")
+// @Region(global=true)
 func ResourceRealtimeLogConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRealtimeLogConfigCreate,
@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_realtime_log_config")
+// @Region(global=true)
 func DataSourceRealtimeLogConfig() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRealtimeLogConfigRead,
@@ -20,7 +20,7 @@ func TestAccCloudFrontRealtimeLogConfigDataSource_basic(t *testing.T) {
 	resourceName := "aws_cloudfront_realtime_log_config.test"
 	dataSourceName := "data.aws_cloudfront_realtime_log_config.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_response_headers_policy")
+// @Region(global=true)
 func ResourceResponseHeadersPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResponseHeadersPolicyCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_response_headers_policy")
+// @Region(global=true)
 func DataSourceResponseHeadersPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceResponseHeadersPolicyRead,
@@ -24,6 +24,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceContinuousDeploymentPolicy,
 			Name:    "Continuous Deployment Policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -33,38 +36,65 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceFunction,
 			TypeName: "aws_cloudfront_function",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceLogDeliveryCanonicalUserID,
 			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOriginAccessIdentities,
 			TypeName: "aws_cloudfront_origin_access_identities",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOriginAccessIdentity,
 			TypeName: "aws_cloudfront_origin_access_identity",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOriginRequestPolicy,
 			TypeName: "aws_cloudfront_origin_request_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceRealtimeLogConfig,
 			TypeName: "aws_cloudfront_realtime_log_config",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceResponseHeadersPolicy,
 			TypeName: "aws_cloudfront_response_headers_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -74,11 +104,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
 			Name:     "Distribution",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
@@ -86,46 +122,79 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceFieldLevelEncryptionConfig,
 			TypeName: "aws_cloudfront_field_level_encryption_config",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceFieldLevelEncryptionProfile,
 			TypeName: "aws_cloudfront_field_level_encryption_profile",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceFunction,
 			TypeName: "aws_cloudfront_function",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceKeyGroup,
 			TypeName: "aws_cloudfront_key_group",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceMonitoringSubscription,
 			TypeName: "aws_cloudfront_monitoring_subscription",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceOriginAccessControl,
 			TypeName: "aws_cloudfront_origin_access_control",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceOriginAccessIdentity,
 			TypeName: "aws_cloudfront_origin_access_identity",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceOriginRequestPolicy,
 			TypeName: "aws_cloudfront_origin_request_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourcePublicKey,
 			TypeName: "aws_cloudfront_public_key",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceRealtimeLogConfig,
 			TypeName: "aws_cloudfront_realtime_log_config",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceResponseHeadersPolicy,
 			TypeName: "aws_cloudfront_response_headers_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
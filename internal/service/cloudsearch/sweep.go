@@ -15,14 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
 		Name: "aws_cloudsearch_domain",
 		F:    sweepDomains,
 	})
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_cloudsearch_domain")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudtrail", name="Trail")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="cloudtrail:trail")
 func ResourceCloudTrail() *schema.Resource { // nosemgrep:ci.cloudtrail-in-func-name
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCloudTrailCreate,
@@ -40,6 +40,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Trail",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
+				PolicyResourceType:  "cloudtrail:trail",
 			},
 		},
 		{
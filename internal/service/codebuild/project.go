@@ -30,7 +30,7 @@ import (
 )
 
 // @SDKResource("aws_codebuild_project", name="Project")
-// @Tags
+// @Tags(tagPolicyResourceType="codebuild:project")
 func ResourceProject() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProjectCreate,
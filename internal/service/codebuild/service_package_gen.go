@@ -33,7 +33,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProject,
 			TypeName: "aws_codebuild_project",
 			Name:     "Project",
-			Tags:     &types.ServicePackageResourceTags{},
+			Tags: &types.ServicePackageResourceTags{
+				PolicyResourceType: "codebuild:project",
+			},
 		},
 		{
 			Factory:  ResourceReportGroup,
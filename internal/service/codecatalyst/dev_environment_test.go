@@ -29,7 +29,7 @@ func TestAccCodeCatalystDevEnvironment_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codecatalyst_dev_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.CodeCatalyst)
@@ -61,7 +61,7 @@ func TestAccCodeCatalystDevEnvironment_withRepositories(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codecatalyst_dev_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.CodeCatalyst)
@@ -96,7 +96,7 @@ func TestAccCodeCatalystDevEnvironment_disappears(t *testing.T) {
 	var DevEnvironment codecatalyst.GetDevEnvironmentOutput
 	resourceName := "aws_codecatalyst_dev_environment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.CodeCatalyst)
@@ -274,6 +274,7 @@ func findProfilingGroupByName(ctx context.Context, conn *codeguruprofiler.Client
 }
 
 type resourceProfilingGroupData struct {
+	framework.WithRegionModel
 	ARN                      types.String                                              `tfsdk:"arn"`
 	AgentOrchestrationConfig fwtypes.ListNestedObjectValueOf[agentOrchestrationConfig] `tfsdk:"agent_orchestration_config"`
 	ComputePlatform          fwtypes.StringEnum[awstypes.ComputePlatform]              `tfsdk:"compute_platform"`
@@ -108,6 +108,7 @@ func (d *dataSourceProfilingGroup) Read(ctx context.Context, req datasource.Read
 }
 
 type dataSourceProfilingGroupData struct {
+	framework.WithRegionModel
 	ARN                      types.String                                                `tfsdk:"arn"`
 	AgentOrchestrationConfig fwtypes.ListNestedObjectValueOf[dsAgentOrchestrationConfig] `tfsdk:"agent_orchestration_config"`
 	ComputePlatform          fwtypes.StringEnum[awstypes.ComputePlatform]                `tfsdk:"compute_platform"`
@@ -25,7 +25,7 @@ func TestAccCodeGuruProfilerProfilingGroupDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_codeguruprofiler_profiling_group.test"
 	resourceName := "aws_codeguruprofiler_profiling_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -29,7 +29,7 @@ func TestAccCodePipeline_basic(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -128,7 +128,7 @@ func TestAccCodePipeline_disappears(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -156,7 +156,7 @@ func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -199,7 +199,7 @@ func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -233,7 +233,7 @@ func TestAccCodePipeline_tags(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -288,7 +288,7 @@ func TestAccCodePipeline_MultiRegion_basic(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -328,7 +328,7 @@ func TestAccCodePipeline_MultiRegion_update(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -381,7 +381,7 @@ func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckMultipleRegion(t, 2)
@@ -443,7 +443,7 @@ func TestAccCodePipeline_withNamespace(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -477,7 +477,7 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -548,7 +548,7 @@ func TestAccCodePipeline_ecr(t *testing.T) {
 	rName := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -598,7 +598,7 @@ func TestAccCodePipeline_pipelinetype(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -20,7 +20,7 @@ func TestAccCodeStarConnectionsConnectionDataSource_basic(t *testing.T) {
 	dataSourceName2 := "data.aws_codestarconnections_connection.test_name"
 	resourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
@@ -55,7 +55,7 @@ func TestAccCodeStarConnectionsConnectionDataSource_tags(t *testing.T) {
 	dataSourceName := "data.aws_codestarconnections_connection.test"
 	resourceName := "aws_codestarconnections_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
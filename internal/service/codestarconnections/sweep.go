@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func RegisterSweepers() {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_codestarconnections_connection")
 	if region == names.USGovEast1RegionID || region == names.USGovWest1RegionID {
 		log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for region: %s", region)
 		return nil
@@ -76,7 +76,7 @@ func sweepConnections(region string) error {
 }
 
 func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_codestarconnections_host")
 	if region == names.USGovEast1RegionID || region == names.USGovWest1RegionID {
 		log.Printf("[WARN] Skipping CodeStar Connections Host sweep for region: %s", region)
 		return nil
@@ -726,6 +726,7 @@ func (r *resourceManagedUserPoolClient) ConfigValidators(ctx context.Context) []
 }
 
 type resourceManagedUserPoolClientData struct {
+	framework.WithRegionModel
 	AccessTokenValidity                      types.Int64    `tfsdk:"access_token_validity"`
 	AllowedOauthFlows                        types.Set      `tfsdk:"allowed_oauth_flows"`
 	AllowedOauthFlowsUserPoolClient          types.Bool     `tfsdk:"allowed_oauth_flows_user_pool_client"`
@@ -604,6 +604,7 @@ func (r *resourceUserPoolClient) ConfigValidators(ctx context.Context) []resourc
 }
 
 type resourceUserPoolClientData struct {
+	framework.WithRegionModel
 	AccessTokenValidity                      types.Int64  `tfsdk:"access_token_validity"`
 	AllowedOauthFlows                        types.Set    `tfsdk:"allowed_oauth_flows"`
 	AllowedOauthFlowsUserPoolClient          types.Bool   `tfsdk:"allowed_oauth_flows_user_pool_client"`
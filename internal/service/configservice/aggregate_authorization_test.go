@@ -8,7 +8,7 @@ package configservice_test
 // 	resourceName := "aws_config_aggregate_authorization.example"
 // 	dataSourceName := "data.aws_region.current"
 
-// 	resource.ParallelTest(t, resource.TestCase{
+// 	acctest.ParallelTest(t, resource.TestCase{
 // 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 // 		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
 // 		ProtoV5ProviderFactories:acctest.ProtoV5ProviderFactories,
@@ -35,7 +35,7 @@ package configservice_test
 // 	rString := sdkacctest.RandStringFromCharSet(12, "0123456789")
 // 	resourceName := "aws_config_aggregate_authorization.example"
 
-// 	resource.ParallelTest(t, resource.TestCase{
+// 	acctest.ParallelTest(t, resource.TestCase{
 // 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 // 		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
 // 		ProtoV5ProviderFactories:acctest.ProtoV5ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccControlTowerControlsDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_controltower_controls.test"
 	ouName := "Security"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckOrganizationManagementAccount(ctx, t)
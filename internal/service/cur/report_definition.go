@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_cur_report_definition")
+// @Region(global=true)
 func ResourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReportDefinitionCreate,
@@ -187,7 +188,7 @@ func resourceReportDefinitionRead(ctx context.Context, d *schema.ResourceData, m
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "cur",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("definition/%s", reportName),
 	}.String()
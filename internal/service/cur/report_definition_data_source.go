@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_cur_report_definition")
+// @Region(global=true)
 func DataSourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceReportDefinitionRead,
@@ -28,6 +28,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceReportDefinition,
 			TypeName: "aws_cur_report_definition",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -37,6 +40,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceReportDefinition,
 			TypeName: "aws_cur_report_definition",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
 }
 
 func sweepReportDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_cur_report_definition")
 	if region != names.USEast1RegionID {
 		log.Printf("[WARN] Skipping Cost And Usage Report Definition sweep for region: %s", region)
 		return nil
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
 		Name: "aws_dataexchange_data_set",
 		F:    sweepDataSets,
 	})
 }
 
 func sweepDataSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_dataexchange_data_set")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
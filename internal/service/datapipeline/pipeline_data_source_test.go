@@ -19,7 +19,7 @@ func TestAccDataPipelinePipelineDataSource_basic(t *testing.T) {
 	resourceName := "aws_datapipeline_pipeline.test"
 	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckPipelineDefinitionDestroy(ctx),
@@ -27,7 +27,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_basic(t *testing.T) {
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 	fsResourceName := "aws_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
@@ -65,7 +65,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_disappears(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
@@ -93,7 +93,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_subdirectory(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
@@ -126,7 +126,7 @@ func TestAccDataSyncLocationFSxLustreFileSystem_tags(t *testing.T) {
 	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
 	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, fsx.EndpointsID)
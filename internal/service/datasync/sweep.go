@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 	})
 
 	// Pseudo-resource for any DataSync location resource type.
-	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
 		Name: "aws_datasync_location",
 		F:    sweepLocations,
 		Dependencies: []string{
@@ -36,14 +36,14 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
 }
 
 func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_datasync_agent")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -87,7 +87,7 @@ func sweepAgents(region string) error {
 }
 
 func sweepLocations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_datasync_location")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -154,7 +154,7 @@ func (sweepable *sweepableLocation) Delete(ctx context.Context, timeout time.Dur
 }
 
 func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_datasync_task")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -28,7 +28,7 @@ func TestAccDirectConnectBGPPeer_basic(t *testing.T) {
 	}
 	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
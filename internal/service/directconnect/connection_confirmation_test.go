@@ -34,7 +34,7 @@ func TestAccDirectConnectConnectionConfirmation_basic(t *testing.T) {
 	providerFunc := testAccConnectionConfirmationProvider(&providers, 0)
 	altProviderFunc := testAccConnectionConfirmationProvider(&providers, 1)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckAlternateAccount(t)
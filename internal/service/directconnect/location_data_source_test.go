@@ -15,7 +15,7 @@ func TestAccDirectConnectLocationDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	dsResourceName := "data.aws_dx_location.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -28,7 +28,7 @@ func TestAccDirectConnectMacSecKey_withCkn(t *testing.T) {
 	ckn := testAccDirecConnectMacSecGenerateHex()
 	cak := testAccDirecConnectMacSecGenerateHex()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -69,7 +69,7 @@ func TestAccDirectConnectMacSecKey_withSecret(t *testing.T) {
 
 	resourceName := "aws_dx_macsec_key_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -24,7 +24,7 @@ func TestAccDirectConnectRouterConfigurationDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_dx_router_configuration.test"
 	routerTypeIdentifier := "CiscoSystemsInc-2900SeriesRouters-IOS124"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, directconnect.EndpointsID)
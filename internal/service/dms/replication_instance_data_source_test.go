@@ -19,7 +19,7 @@ func TestAccDMSReplicationInstanceDataSource_basic(t *testing.T) {
 	resourceName := "aws_dms_replication_instance.test"
 	dataSourceName := "data.aws_dms_replication_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -18,7 +18,7 @@ func TestAccDMSReplicationSubnetGroupDataSource_basic(t *testing.T) {
 	resourceName := "aws_dms_replication_subnet_group.test"
 	dataSourceName := "data.aws_dms_replication_subnet_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
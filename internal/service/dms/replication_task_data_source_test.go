@@ -19,7 +19,7 @@ func TestAccDMSReplicationTaskDataSource_basic(t *testing.T) {
 	resourceName := "aws_dms_replication_task.test"
 	dataSourceName := "data.aws_dms_replication_task.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
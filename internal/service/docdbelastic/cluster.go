@@ -377,6 +377,7 @@ func (r *resourceCluster) ModifyPlan(ctx context.Context, request resource.Modif
 }
 
 type resourceClusterData struct {
+	framework.WithRegionModel
 	AdminUserName              types.String   `tfsdk:"admin_user_name"`
 	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
 	ARN                        types.String   `tfsdk:"arn"`
@@ -17,14 +17,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
 		Name: "aws_docdbelastic_cluster",
 		F:    sweepClusters,
 	})
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_docdbelastic_cluster")
 	if region == names.USWest1RegionID {
 		log.Printf("[WARN] Skipping DocDB Elastic Cluster sweep for region: %s", region)
 		return nil
@@ -356,6 +356,7 @@ func (r *resourceTrust) ImportState(ctx context.Context, req resource.ImportStat
 }
 
 type resourceTrustData struct {
+	framework.WithRegionModel
 	ConditionalForwarderIpAddrs          types.Set    `tfsdk:"conditional_forwarder_ip_addrs"`
 	CreatedDateTime                      types.String `tfsdk:"created_date_time"`
 	DeleteAssociatedConditionalForwarder types.Bool   `tfsdk:"delete_associated_conditional_forwarder"`
@@ -28,7 +28,7 @@ func TestAccDSTrust_basic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -79,7 +79,7 @@ func TestAccDSTrust_Domain_TrailingPeriod(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -118,7 +118,7 @@ func TestAccDSTrust_twoWayBasic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -162,7 +162,7 @@ func TestAccDSTrust_oneWayBasic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -205,7 +205,7 @@ func TestAccDSTrust_SelectiveAuth(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -262,7 +262,7 @@ func TestAccDSTrust_twoWaySelectiveAuth(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -319,7 +319,7 @@ func TestAccDSTrust_TrustType(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -358,7 +358,7 @@ func TestAccDSTrust_TrustTypeSpecifyDefault(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -390,7 +390,7 @@ func TestAccDSTrust_ConditionalForwarderIPs(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
@@ -445,7 +445,7 @@ func TestAccDSTrust_deleteAssociatedConditionalForwarder(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	domainNameOther := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckDirectoryService(ctx, t)
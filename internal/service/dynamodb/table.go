@@ -39,7 +39,7 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="dynamodb:table")
 func ResourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -1079,7 +1079,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 			var input = &dynamodb.UpdateReplicationGroupMemberAction{
 				KMSMasterKeyId: expandEncryptAtRestOptions(d.Get("server_side_encryption").([]interface{})).KMSMasterKeyId,
-				RegionName:     aws.String(meta.(*conns.AWSClient).Region),
+				RegionName:     aws.String(meta.(*conns.AWSClient).RegionForContext(ctx)),
 			}
 			var update = &dynamodb.ReplicationGroupUpdate{Update: input}
 			replicaInputs = append(replicaInputs, update)
@@ -1893,7 +1893,7 @@ func clearSSEDefaultKey(ctx context.Context, sseList []interface{}, meta interfa
 
 	sse := sseList[0].(map[string]interface{})
 
-	dk, err := kms.FindDefaultKey(ctx, "dynamodb", meta.(*conns.AWSClient).Region, meta)
+	dk, err := kms.FindDefaultKey(ctx, "dynamodb", meta.(*conns.AWSClient).RegionForContext(ctx), meta)
 	if err != nil {
 		return sseList
 	}
@@ -28,7 +28,7 @@ func TestAccDynamoDBTableItemDataSource_basic(t *testing.T) {
 	key := `{
 	"hashKey": {"S": "something"}
 }`
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, dynamodb.EndpointsID)
@@ -69,7 +69,7 @@ func TestAccDynamoDBTableItemDataSource_projectionExpression(t *testing.T) {
 	"two": {"N": "22222"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, dynamodb.EndpointsID)
@@ -107,7 +107,7 @@ func TestAccDynamoDBTableItemDataSource_expressionAttributeNames(t *testing.T) {
 	"Percentile": {"N": "22222"}
 }`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, dynamodb.EndpointsID)
@@ -298,6 +298,7 @@ func findEBSFastSnapshotRestoreByID(ctx context.Context, conn *ec2.Client, id st
 }
 
 type resourceEBSFastSnapshotRestoreData struct {
+	framework.WithRegionModel
 	AvailabilityZone types.String   `tfsdk:"availability_zone"`
 	ID               types.String   `tfsdk:"id"`
 	SnapshotID       types.String   `tfsdk:"snapshot_id"`
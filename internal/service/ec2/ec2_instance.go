@@ -40,7 +40,8 @@ import (
 )
 
 // @SDKResource("aws_instance", name="Instance")
-// @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="ec2:instance")
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -936,8 +937,13 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "collecting instance settings: %s", err)
 	}
 
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tagSpecifications := getTagSpecificationsIn(ctx, ec2.ResourceTypeInstance)
-	tagSpecifications = append(tagSpecifications, tagSpecificationsFromMap(ctx, d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
+	tagSpecifications = append(tagSpecifications, tagSpecificationsFromKeyValue(defaultTagsConfig.PropagatedTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))), ec2.ResourceTypeVolume)...)
+	// Network interfaces attached via network_interface already exist and aren't created by RunInstances.
+	if _, ok := d.GetOk("network_interface"); !ok {
+		tagSpecifications = append(tagSpecifications, tagSpecificationsFromKeyValue(defaultTagsConfig.PropagatedTags(nil), ec2.ResourceTypeNetworkInterface)...)
+	}
 	input := &ec2.RunInstancesInput{
 		BlockDeviceMappings:               instanceOpts.BlockDeviceMappings,
 		CapacityReservationSpecification:  instanceOpts.CapacityReservationSpecification,
@@ -1065,6 +1071,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 
 	instance, err := FindInstanceByID(ctx, conn, d.Id())
 
@@ -1277,7 +1284,8 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		if err := d.Set("volume_tags", KeyValueTags(ctx, volumeTags).IgnoreAWS().Map()); err != nil {
+		configuredVolumeTags := tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))
+		if err := d.Set("volume_tags", defaultTagsConfig.RemovePropagatedTags(KeyValueTags(ctx, volumeTags).IgnoreAWS(), configuredVolumeTags).Map()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting volume_tags: %s", err)
 		}
 	}
@@ -1291,7 +1299,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 	}
 
-	if err := readBlockDevices(ctx, d, instance, conn); err != nil {
+	if err := readBlockDevices(ctx, d, instance, conn, defaultTagsConfig); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
@@ -1302,7 +1310,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		Service:   ec2.ServiceName,
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("instance/%s", d.Id()),
@@ -2106,8 +2114,10 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 	return nil
 }
 
-func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
-	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn)
+// readBlockDevices sets the instance's block devices in state.
+// Any default tags propagated to the block devices' volumes are removed using defaultTagsConfig, which may be nil.
+func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, defaultTagsConfig *tftags.DefaultConfig) error {
+	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn, defaultTagsConfig)
 	if err != nil {
 		return fmt.Errorf("reading block devices: %w", err)
 	}
@@ -2194,7 +2204,7 @@ func disassociateInstanceProfile(ctx context.Context, associationId *string, con
 	return nil
 }
 
-func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) (map[string]interface{}, error) {
+func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, defaultTagsConfig *tftags.DefaultConfig) (map[string]interface{}, error) {
 	blockDevices := make(map[string]interface{})
 	blockDevices["ebs"] = make([]map[string]interface{}, 0)
 	blockDevices["root"] = nil
@@ -2256,7 +2266,8 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, i
 			bd["device_name"] = aws.StringValue(instanceBd.DeviceName)
 		}
 		if v, ok := d.GetOk("volume_tags"); (!ok || v == nil || len(v.(map[string]interface{})) == 0) && vol.Tags != nil {
-			bd["tags"] = KeyValueTags(ctx, vol.Tags).IgnoreAWS().Map()
+			configuredTags := configuredBlockDeviceTags(ctx, d, aws.StringValue(instanceBd.DeviceName), blockDeviceIsRoot(instanceBd, instance))
+			bd["tags"] = defaultTagsConfig.RemovePropagatedTags(KeyValueTags(ctx, vol.Tags).IgnoreAWS(), configuredTags).Map()
 		}
 
 		if blockDeviceIsRoot(instanceBd, instance) {
@@ -3224,6 +3235,30 @@ func getVolumeIdByDeviceName(instance *ec2.Instance, deviceName string) string {
 	return volumeId
 }
 
+// configuredBlockDeviceTags returns the tags configured for the root or EBS block device with the specified device name.
+func configuredBlockDeviceTags(ctx context.Context, d *schema.ResourceData, deviceName string, root bool) tftags.KeyValueTags {
+	if root {
+		if v, ok := d.Get("root_block_device.0.tags").(map[string]interface{}); ok {
+			return tftags.New(ctx, v)
+		}
+
+		return nil
+	}
+
+	if v, ok := d.GetOk("ebs_block_device"); ok {
+		for _, v := range v.(*schema.Set).List() {
+			bd := v.(map[string]interface{})
+			if bd["device_name"].(string) == deviceName {
+				if v, ok := bd["tags"].(map[string]interface{}); ok {
+					return tftags.New(ctx, v)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func blockDeviceTagsDefined(d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("root_block_device"); ok {
 		vL := v.([]interface{})
@@ -266,6 +266,7 @@ func (r *resourceInstanceConnectEndpoint) ModifyPlan(ctx context.Context, reques
 
 // See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_Ec2InstanceConnectEndpoint.html.
 type resourceInstanceConnectEndpointData struct {
+	framework.WithRegionModel
 	InstanceConnectEndpointArn types.String   `tfsdk:"arn"`
 	AvailabilityZone           types.String   `tfsdk:"availability_zone"`
 	DnsName                    types.String   `tfsdk:"dns_name"`
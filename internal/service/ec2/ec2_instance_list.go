@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_instance", name="Instance")
+func newInstanceListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithConfigure
+	framework.WithSDKv2ResourceList
+}
+
+func (l *instanceListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			"filter": CustomFiltersListResourceBlock(),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data instanceListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Conn(ctx)
+
+	// Terminated instances are treated as not found by the resource.
+	input := &ec2.DescribeInstancesInput{
+		Filters: attributeFiltersFromMultimap(map[string][]string{
+			"instance-state-name": {
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameShuttingDown,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			},
+		}),
+	}
+
+	input.Filters = append(input.Filters, BuildCustomListFilters(ctx, data.Filters)...)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := conn.DescribeInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Reservations {
+				if v == nil {
+					continue
+				}
+
+				for _, v := range v.Instances {
+					if v == nil {
+						continue
+					}
+
+					id := aws.StringValue(v.InstanceId)
+
+					d := l.ResourceData()
+					d.SetId(id)
+
+					result := request.NewListResult(ctx)
+					result.DisplayName = id
+					if name := instanceNameTag(v); name != "" {
+						result.DisplayName = fmt.Sprintf("%s (%s)", name, id)
+					}
+
+					if !l.SetResult(ctx, l.Meta(), request.IncludeResource, d, &result) {
+						continue
+					}
+
+					if !yield(result) {
+						return false
+					}
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError(create.ProblemStandardMessage(names.EC2, create.ErrActionListing, "Instances", "", nil), err.Error())
+			yield(result)
+		}
+	}
+}
+
+// instanceNameTag returns the value of the instance's "Name" tag, if any.
+func instanceNameTag(instance *ec2.Instance) string {
+	for _, v := range instance.Tags {
+		if aws.StringValue(v.Key) == "Name" {
+			return aws.StringValue(v.Value)
+		}
+	}
+
+	return ""
+}
+
+type instanceListResourceModel struct {
+	Filters types.List   `tfsdk:"filter"`
+	Region  types.String `tfsdk:"region"`
+}
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_key_pair", name="Key Pair")
-// @Tags(identifierAttribute="key_pair_id")
+// @Tags(identifierAttribute="key_pair_id", tagPolicyResourceType="ec2:key-pair")
 func ResourceKeyPair() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -138,7 +138,7 @@ func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta inter
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("key-pair/%s", d.Id()),
 	}.String()
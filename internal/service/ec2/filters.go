@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -165,6 +166,23 @@ func CustomFiltersBlock() datasourceschema.Block {
 	}
 }
 
+// CustomFiltersListResourceBlock is the Plugin Framework list resource variant of CustomFiltersSchema.
+func CustomFiltersListResourceBlock() listschema.Block {
+	return listschema.ListNestedBlock{
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				"name": listschema.StringAttribute{
+					Required: true,
+				},
+				"values": listschema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
 // customFilterData represents a single configured filter.
 type customFilterData struct {
 	Name   types.String `tfsdk:"name"`
@@ -235,6 +253,42 @@ func BuildCustomFilters(ctx context.Context, filterSet types.Set) []*ec2.Filter
 	return filters
 }
 
+// customListFilterData represents a single configured list resource filter.
+type customListFilterData struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// BuildCustomListFilters is the list resource variant of BuildCustomFilters.
+func BuildCustomListFilters(ctx context.Context, filterList types.List) []*ec2.Filter {
+	if filterList.IsNull() || filterList.IsUnknown() {
+		return nil
+	}
+
+	var filters []*ec2.Filter
+
+	for _, v := range filterList.Elements() {
+		var data customListFilterData
+
+		if tfsdk.ValueAs(ctx, v, &data).HasError() {
+			continue
+		}
+
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		if v := flex.ExpandFrameworkStringList(ctx, data.Values); v != nil {
+			filters = append(filters, &ec2.Filter{
+				Name:   flex.StringFromFramework(ctx, data.Name),
+				Values: v,
+			})
+		}
+	}
+
+	return filters
+}
+
 func BuildCustomFiltersV2(ctx context.Context, filterSet types.Set) []awstypes.Filter {
 	if filterSet.IsNull() || filterSet.IsUnknown() {
 		return nil
@@ -51,7 +51,7 @@ func TestAccIPAM_byoipIPv6(t *testing.T) {
 	var associationIPv6 ec2.VpcIpv6CidrBlockAssociation
 	netmaskLength := 56
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
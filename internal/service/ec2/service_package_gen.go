@@ -58,6 +58,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newInstanceListResource,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -401,6 +411,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "AMI",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:image",
 			},
 		},
 		{
@@ -493,6 +504,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "EBS Snapshot",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:snapshot",
 			},
 		},
 		{
@@ -517,6 +529,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "EBS Volume",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:volume",
 			},
 		},
 		{
@@ -790,6 +803,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "EIP",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:elastic-ip",
 			},
 		},
 		{
@@ -810,6 +824,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Instance",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:instance",
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "id",
+						Required: true,
+					},
+				},
 			},
 		},
 		{
@@ -818,6 +841,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Internet Gateway",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:internet-gateway",
 			},
 		},
 		{
@@ -830,6 +854,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Key Pair",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "key_pair_id",
+				PolicyResourceType:  "ec2:key-pair",
 			},
 		},
 		{
@@ -838,6 +863,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Launch Template",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:launch-template",
 			},
 		},
 		{
@@ -850,6 +876,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "NAT Gateway",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:natgateway",
 			},
 		},
 		{
@@ -874,6 +901,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Network Interface",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:network-interface",
 			},
 		},
 		{
@@ -902,6 +930,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Route Table",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:route-table",
 			},
 		},
 		{
@@ -914,6 +943,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Security Group",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:security-group",
 			},
 		},
 		{
@@ -950,6 +980,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Subnet",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:subnet",
 			},
 		},
 		{
@@ -1004,6 +1035,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "VPC",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ec2:vpc",
 			},
 		},
 		{
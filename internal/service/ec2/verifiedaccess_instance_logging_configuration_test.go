@@ -31,7 +31,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsIncludeTrustCon
 	include_trust_context_original := true
 	include_trust_context_updated := false
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -78,7 +78,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsLogVersion(t *t
 	log_version_original := "ocsf-0.1"
 	log_version_updated := "ocsf-1.0.0-rc.2"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -124,7 +124,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsCloudWatchLogs(
 	logGroupName := "aws_cloudwatch_log_group.test"
 	logGroupName2 := "aws_cloudwatch_log_group.test2"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -178,7 +178,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsKinesisDataFire
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -233,7 +233,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsS3(t *testing.T
 	prefix_original := "prefix-original"
 	prefix_updated := "prefix-updated"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -292,7 +292,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_accessLogsCloudWatchLogsK
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -388,7 +388,7 @@ func TestAccVerifiedAccessInstanceLoggingConfiguration_disappears(t *testing.T)
 	var v types.VerifiedAccessInstanceLoggingConfiguration
 	resourceName := "aws_verifiedaccess_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
@@ -26,7 +26,7 @@ func TestAccVerifiedAccessInstance_basic(t *testing.T) {
 	var v types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -62,7 +62,7 @@ func TestAccVerifiedAccessInstance_description(t *testing.T) {
 	originalDescription := "original description"
 	updatedDescription := "updated description"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -104,7 +104,7 @@ func TestAccVerifiedAccessInstance_fipsEnabled(t *testing.T) {
 	originalFipsEnabled := true
 	updatedFipsEnabled := false
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -143,7 +143,7 @@ func TestAccVerifiedAccessInstance_disappears(t *testing.T) {
 	var v types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -169,7 +169,7 @@ func TestAccVerifiedAccessInstance_tags(t *testing.T) {
 	var v1, v2, v3 types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -23,7 +23,7 @@ func TestAccVerifiedAccessInstanceTrustProviderAttachment_basic(t *testing.T) {
 	instanceResourceName := "aws_verifiedaccess_instance.test"
 	trustProviderResourceName := "aws_verifiedaccess_trust_provider.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
@@ -53,7 +53,7 @@ func TestAccVerifiedAccessInstanceTrustProviderAttachment_disappears(t *testing.
 	ctx := acctest.Context(t)
 	resourceName := "aws_verifiedaccess_instance_trust_provider_attachment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccessInstance(ctx, t)
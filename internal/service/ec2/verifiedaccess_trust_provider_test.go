@@ -29,7 +29,7 @@ func TestAccVerifiedAccessTrustProvider_basic(t *testing.T) {
 	userTrustProviderType := "iam-identity-center"
 	description := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EC2)
@@ -69,7 +69,7 @@ func TestAccVerifiedAccessTrustProvider_deviceOptions(t *testing.T) {
 	deviceTrustProviderType := "jamf"
 	tenantId := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccess(ctx, t)
@@ -108,7 +108,7 @@ func TestAccVerifiedAccessTrustProvider_disappears(t *testing.T) {
 	userTrustProviderType := "iam-identity-center"
 	description := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EC2)
@@ -146,7 +146,7 @@ func TestAccVerifiedAccessTrustProvider_oidcOptions(t *testing.T) {
 	tokenEndpoint := "https://token.example.com"
 	userInfoEndpoint := "https://user.example.com"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccess(ctx, t)
@@ -191,7 +191,7 @@ func TestAccVerifiedAccessTrustProvider_tags(t *testing.T) {
 	userTrustProviderType := "iam-identity-center"
 	description := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckVerifiedAccess(ctx, t)
@@ -25,7 +25,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -51,7 +51,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_multiple(t *testing.T) {
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 	serviceResourceName := "aws_vpc_endpoint_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -78,7 +78,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_tags(t *testing.T) {
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 	tagResourceName := "aws_ec2_tag.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -103,7 +103,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_migrateID(t *testing.T) {
 
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
 		CheckDestroy: testAccCheckVPCEndpointServiceAllowedPrincipalDestroy(ctx),
@@ -137,7 +137,7 @@ func TestAccVPCEndpointServiceAllowedPrincipal_migrateAndTag(t *testing.T) {
 	resourceName := "aws_vpc_endpoint_service_allowed_principal.test"
 	tagResourceName := "aws_ec2_tag.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
 		CheckDestroy: testAccCheckVPCEndpointServiceAllowedPrincipalDestroy(ctx),
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_nat_gateway", name="NAT Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="ec2:natgateway")
 func ResourceNATGateway() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNATGatewayCreate,
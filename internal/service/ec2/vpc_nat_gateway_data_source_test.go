@@ -21,7 +21,7 @@ func TestAccVPCNATGatewayDataSource_basic(t *testing.T) {
 	dataSourceNameByTags := "data.aws_nat_gateway.test_by_tags"
 	resourceName := "aws_nat_gateway.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -356,11 +356,11 @@ func (r *resourceSecurityGroupRule) ConfigValidators(_ context.Context) []resour
 	}
 }
 
-func (r *resourceSecurityGroupRule) arn(_ context.Context, id string) types.String {
+func (r *resourceSecurityGroupRule) arn(ctx context.Context, id string) types.String {
 	arn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   ec2.ServiceName,
-		Region:    r.Meta().Region,
+		Region:    r.Meta().RegionForContext(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("security-group-rule/%s", id),
 	}.String()
@@ -441,6 +441,7 @@ func (r *resourceSecurityGroupRule) flattenReferencedSecurityGroup(ctx context.C
 }
 
 type resourceSecurityGroupRuleData struct {
+	framework.WithRegionModel
 	ARN                       types.String `tfsdk:"arn"`
 	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
 	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
@@ -133,12 +133,12 @@ func (d *dataSourceSecurityGroupRule) Read(ctx context.Context, request datasour
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func (d *dataSourceSecurityGroupRule) arn(_ context.Context, id string) types.String {
+func (d *dataSourceSecurityGroupRule) arn(ctx context.Context, id string) types.String {
 	// TODO Consider reusing resourceSecurityGroupRule.arn().
 	arn := arn.ARN{
 		Partition: d.Meta().Partition,
 		Service:   ec2.ServiceName,
-		Region:    d.Meta().Region,
+		Region:    d.Meta().RegionForContext(ctx),
 		AccountID: d.Meta().AccountID,
 		Resource:  fmt.Sprintf("security-group-rule/%s", id),
 	}.String()
@@ -160,6 +160,7 @@ func (d *dataSourceSecurityGroupRule) flattenReferencedSecurityGroup(ctx context
 }
 
 type dataSourceSecurityGroupRuleData struct {
+	framework.WithRegionModel
 	ARN                       types.String `tfsdk:"arn"`
 	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
 	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
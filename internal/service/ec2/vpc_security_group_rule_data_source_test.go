@@ -19,7 +19,7 @@ func TestAccVPCSecurityGroupRuleDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_vpc_security_group_rule.test"
 	resourceName := "aws_vpc_security_group_ingress_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccVPCSecurityGroupRuleDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_vpc_security_group_rule.test"
 	resourceName := "aws_vpc_security_group_egress_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -78,13 +78,14 @@ func (d *dataSourceSecurityGroupRules) Read(ctx context.Context, request datasou
 		securityGroupRuleIDs = append(securityGroupRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
 	}
 
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
 	data.IDs = flex.FlattenFrameworkStringValueList(ctx, securityGroupRuleIDs)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceSecurityGroupRulesData struct {
+	framework.WithRegionModel
 	Filters types.Set    `tfsdk:"filter"`
 	ID      types.String `tfsdk:"id"`
 	IDs     types.List   `tfsdk:"ids"`
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_subnet", name="Subnet")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="ec2:subnet")
 func ResourceSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
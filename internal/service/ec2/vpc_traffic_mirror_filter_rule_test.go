@@ -35,7 +35,7 @@ func TestAccVPCTrafficMirrorFilterRule_basic(t *testing.T) {
 	dstPortFrom := 10000
 	dstPortTo := 10001
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
@@ -140,7 +140,7 @@ func TestAccVPCTrafficMirrorFilterRule_disappears(t *testing.T) {
 	action := "accept"
 	direction := "ingress"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
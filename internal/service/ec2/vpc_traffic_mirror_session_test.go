@@ -31,7 +31,7 @@ func TestAccVPCTrafficMirrorSession_basic(t *testing.T) {
 	pLen := sdkacctest.RandIntRange(1, 255)
 	vni := sdkacctest.RandIntRange(1, 16777216)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorSession(ctx, t)
@@ -93,7 +93,7 @@ func TestAccVPCTrafficMirrorSession_tags(t *testing.T) {
 	session := sdkacctest.RandIntRange(1, 32766)
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorSession(ctx, t)
@@ -143,7 +143,7 @@ func TestAccVPCTrafficMirrorSession_disappears(t *testing.T) {
 	session := sdkacctest.RandIntRange(1, 32766)
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorSession(ctx, t)
@@ -171,7 +171,7 @@ func TestAccVPCTrafficMirrorSession_updateTrafficMirrorTarget(t *testing.T) {
 	session := sdkacctest.RandIntRange(1, 32766)
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorSession(ctx, t)
@@ -26,7 +26,7 @@ func TestAccVPCTrafficMirrorTarget_nlb(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorTarget(ctx, t)
@@ -62,7 +62,7 @@ func TestAccVPCTrafficMirrorTarget_eni(t *testing.T) {
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 	description := "test eni target"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorTarget(ctx, t)
@@ -95,7 +95,7 @@ func TestAccVPCTrafficMirrorTarget_tags(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorTarget(ctx, t)
@@ -145,7 +145,7 @@ func TestAccVPCTrafficMirrorTarget_disappears(t *testing.T) {
 	description := "test nlb target"
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorTarget(ctx, t)
@@ -172,7 +172,7 @@ func TestAccVPCTrafficMirrorTarget_gwlb(t *testing.T) {
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
 	description := "test gwlb endpoint target"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckTrafficMirrorTarget(ctx, t)
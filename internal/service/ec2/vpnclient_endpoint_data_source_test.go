@@ -20,7 +20,7 @@ func testAccClientVPNEndpointDataSource_basic(t *testing.T) {
 	datasource2Name := "data.aws_ec2_client_vpn_endpoint.by_filter"
 	datasource3Name := "data.aws_ec2_client_vpn_endpoint.by_tags"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
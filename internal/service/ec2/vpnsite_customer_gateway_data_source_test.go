@@ -21,7 +21,7 @@ func TestAccSiteVPNCustomerGatewayDataSource_filter(t *testing.T) {
 	asn := sdkacctest.RandIntRange(64512, 65534)
 	hostOctet := sdkacctest.RandIntRange(1, 254)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -51,7 +51,7 @@ func TestAccSiteVPNCustomerGatewayDataSource_id(t *testing.T) {
 	asn := sdkacctest.RandIntRange(64512, 65534)
 	hostOctet := sdkacctest.RandIntRange(1, 254)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -64,13 +64,14 @@ func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 	}
 
-	data.ID = flex.StringValueToFramework(ctx, d.Meta().Region)
+	data.ID = flex.StringValueToFramework(ctx, d.Meta().RegionForContext(ctx))
 	data.Names = flex.FlattenFrameworkStringValueSet(ctx, names)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 type repositoriesDataSourceModel struct {
+	framework.WithRegionModel
 	ID    fwtypes.String `tfsdk:"id"`
 	Names fwtypes.Set    `tfsdk:"names"`
 }
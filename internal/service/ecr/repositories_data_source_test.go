@@ -24,7 +24,7 @@ func TestAccECRRepositoriesDataSource_basic(t *testing.T) {
 	}
 	dataSourceName := "data.aws_ecr_repositories.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.ECREndpointID)
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ecr_repository", name="Repository")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="ecr:repository")
 func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
@@ -79,6 +79,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Repository",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
+				PolicyResourceType:  "ecr:repository",
 			},
 		},
 		{
@@ -54,7 +54,7 @@ func resourceAccountSettingDefaultImport(ctx context.Context, d *schema.Resource
 	d.Set("name", d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   ecs.ServiceName,
 		Resource:  fmt.Sprintf("cluster/%s", d.Id()),
@@ -68,6 +68,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Cluster",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ecs:cluster",
 			},
 		},
 		{
@@ -80,6 +81,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Service",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "ecs:service",
 			},
 		},
 		{
@@ -92,6 +94,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Task Definition",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
+				PolicyResourceType:  "ecs:task-definition",
 			},
 		},
 		{
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_ecs_task_definition", name="Task Definition")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="ecs:task-definition")
 func ResourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -24,7 +24,7 @@ func TestAccECSTaskExecutionDataSource_basic(t *testing.T) {
 	clusterName := "aws_ecs_cluster.test"
 	taskDefinitionName := "aws_ecs_task_definition.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, ecs.EndpointsID)
@@ -58,7 +58,7 @@ func TestAccECSTaskExecutionDataSource_overrides(t *testing.T) {
 	clusterName := "aws_ecs_cluster.test"
 	taskDefinitionName := "aws_ecs_task_definition.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, ecs.EndpointsID)
@@ -97,7 +97,7 @@ func TestAccECSTaskExecutionDataSource_tags(t *testing.T) {
 	clusterName := "aws_ecs_cluster.test"
 	taskDefinitionName := "aws_ecs_task_definition.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, ecs.EndpointsID)
@@ -64,6 +64,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "File System",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "elasticfilesystem:file-system",
 			},
 		},
 		{
@@ -19,7 +19,7 @@ func TestAccEKSAccessEntryDataSource_basic(t *testing.T) {
 	dataSourceResourceName := "data.aws_eks_access_entry.test"
 	resourceName := "aws_eks_access_entry.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
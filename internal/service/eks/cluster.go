@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="eks:cluster")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -39,6 +39,7 @@ func newPodIdentityAssociationResource(_ context.Context) (resource.ResourceWith
 }
 
 type podIdentityAssociationResourceModel struct {
+	framework.WithRegionModel
 	AssociationARN types.String `tfsdk:"association_arn"`
 	AssociationID  types.String `tfsdk:"association_id"`
 	ClusterName    types.String `tfsdk:"cluster_name"`
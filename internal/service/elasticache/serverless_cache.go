@@ -449,6 +449,7 @@ func (r *resourceServerlessCache) ModifyPlan(ctx context.Context, request resour
 }
 
 type resourceServerlessData struct {
+	framework.WithRegionModel
 	ARN                    types.String                                      `tfsdk:"arn"`
 	CacheUsageLimits       fwtypes.ListNestedObjectValueOf[cacheUsageLimits] `tfsdk:"cache_usage_limits"`
 	CreateTime             fwtypes.Timestamp                                 `tfsdk:"create_time"`
@@ -29,7 +29,7 @@ func TestAccElastiCacheServerlessCache_basic(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -72,7 +72,7 @@ func TestAccElastiCacheServerlessCache_basicRedis(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -116,7 +116,7 @@ func TestAccElastiCacheServerlessCache_full(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -159,7 +159,7 @@ func TestAccElastiCacheServerlessCache_fullRedis(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -204,7 +204,7 @@ func TestAccElastiCacheServerlessCache_update(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -265,7 +265,7 @@ func TestAccElastiCacheServerlessCache_disappears(t *testing.T) {
 	resourceName := "aws_elasticache_serverless_cache.test"
 	var serverlessElasticCache awstypes.ServerlessCache
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -310,7 +310,7 @@ func TestAccElastiCacheServerlessCache_tags(t *testing.T) {
     key2 = "value2"
   }
 `
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
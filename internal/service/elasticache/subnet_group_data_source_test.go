@@ -24,7 +24,7 @@ func TestAccElastiCacheSubnetGroupDataSource_basic(t *testing.T) {
 	resourceName := "aws_elasticache_subnet_group.test"
 	dataSourceName := "data.aws_elasticache_subnet_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
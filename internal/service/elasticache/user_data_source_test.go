@@ -19,7 +19,7 @@ func TestAccElastiCacheUserDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_elasticache_user.test-basic"
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
@@ -60,7 +60,7 @@ func DataSourceHostedZone() *schema.Resource {
 
 func dataSourceHostedZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
 	}
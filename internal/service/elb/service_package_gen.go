@@ -52,6 +52,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Classic Load Balancer",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
+				PolicyResourceType:  "elasticloadbalancing:loadbalancer",
 			},
 		},
 		{
@@ -20,7 +20,7 @@ func TestAccELBV2ListenerDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_lb_listener.test"
 	dataSourceName2 := "data.aws_alb_listener.from_lb_and_port"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -27,7 +27,7 @@ func TestAccELBV2LoadBalancersDataSource_basic(t *testing.T) {
 	dataSourceNameMatchBothTag := "data.aws_lbs.tag_match_shared"
 	dataSourceNameMatchNoneTag := "data.aws_lbs.tag_match_none"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, elbv2.EndpointsID)
@@ -20,7 +20,7 @@ func TestAccELBV2TrustStoreDataSource_basic(t *testing.T) {
 	datasourceNameByName := "data.aws_lb_trust_store.named"
 	datasourceNameByArn := "data.aws_lb_trust_store.with_arn"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -28,7 +28,7 @@ func TestAccEMRStudioSessionMapping_basic(t *testing.T) {
 	uName := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
 	gName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckUserID(t)
@@ -95,7 +95,7 @@ func TestAccEMRStudioSessionMapping_disappears(t *testing.T) {
 	uName := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
 	gName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckUserID(t)
@@ -124,6 +124,7 @@ func (d *dataSourceSupportedInstanceTypes) Read(ctx context.Context, req datasou
 }
 
 type dataSourceSupportedInstanceTypesData struct {
+	framework.WithRegionModel
 	ID                     types.String `tfsdk:"id"`
 	ReleaseLabel           types.String `tfsdk:"release_label"`
 	SupportedInstanceTypes types.List   `tfsdk:"supported_instance_types"`
@@ -15,19 +15,19 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 
-	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
 		Name: "aws_emrcontainers_job_template",
 		F:    sweepJobTemplates,
 	})
 }
 
 func sweepVirtualClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_emrcontainers_virtual_cluster")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -75,7 +75,7 @@ func sweepVirtualClusters(region string) error {
 }
 
 func sweepJobTemplates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_emrcontainers_job_template")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -19,7 +19,7 @@ func TestAccEventsBusDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_cloudwatch_event_bus.test"
 	resourceName := "aws_cloudwatch_event_bus.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -28,7 +28,7 @@ func TestAccEvidentlyFeature_basic(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -80,7 +80,7 @@ func TestAccEvidentlyFeature_updateDefaultVariation(t *testing.T) {
 	variationName2 := "Variation2"
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -122,7 +122,7 @@ func TestAccEvidentlyFeature_updateDescription(t *testing.T) {
 	updatedDescription := "updated description"
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -164,7 +164,7 @@ func TestAccEvidentlyFeature_updateEntityOverrides(t *testing.T) {
 	variationName2 := "Variation2"
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -229,7 +229,7 @@ func TestAccEvidentlyFeature_updateEvaluationStrategy(t *testing.T) {
 	updatedEvaluationStategy := string(awstypes.FeatureEvaluationStrategyDefaultVariation)
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -275,7 +275,7 @@ func TestAccEvidentlyFeature_updateVariationsBoolValue(t *testing.T) {
 	variationBoolVal2 := true
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -340,7 +340,7 @@ func TestAccEvidentlyFeature_updateVariationsDoubleValue(t *testing.T) {
 	variationDoubleVal2 := 3
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -405,7 +405,7 @@ func TestAccEvidentlyFeature_updateVariationsLongValue(t *testing.T) {
 	variationLongVal2 := 3
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -471,7 +471,7 @@ func TestAccEvidentlyFeature_updateVariationsStringValue(t *testing.T) {
 	updatedVariationStringVal2 := ""
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -549,7 +549,7 @@ func TestAccEvidentlyFeature_tags(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -600,7 +600,7 @@ func TestAccEvidentlyFeature_disappears(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_evidently_feature.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.EvidentlyEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccEvidentlyLaunch_basic(t *testing.T) {
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -86,7 +86,7 @@ func TestAccEvidentlyLaunch_updateDescription(t *testing.T) {
 	updatedDescription := "updated description"
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -130,7 +130,7 @@ func TestAccEvidentlyLaunch_updateGroups(t *testing.T) {
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -212,7 +212,7 @@ func TestAccEvidentlyLaunch_updateMetricMonitors(t *testing.T) {
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -304,7 +304,7 @@ func TestAccEvidentlyLaunch_updateRandomizationSalt(t *testing.T) {
 	updatedRandomizationSalt := "updated randomization salt"
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -350,7 +350,7 @@ func TestAccEvidentlyLaunch_scheduledSplitsConfig_updateSteps(t *testing.T) {
 	startTime5 := time.Now().AddDate(0, 0, 6).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -439,7 +439,7 @@ func TestAccEvidentlyLaunch_scheduledSplitsConfig_steps_updateSegmentOverrides(t
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -546,7 +546,7 @@ func TestAccEvidentlyLaunch_tags(t *testing.T) {
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -599,7 +599,7 @@ func TestAccEvidentlyLaunch_disappears(t *testing.T) {
 	startTime := time.Now().AddDate(0, 0, 2).Format("2006-01-02T15:04:05Z")
 	resourceName := "aws_evidently_launch.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.EvidentlyEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
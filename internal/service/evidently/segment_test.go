@@ -27,7 +27,7 @@ func TestAccEvidentlySegment_basic(t *testing.T) {
 	resourceName := "aws_evidently_segment.test"
 	pattern := "{\"Price\":[{\"numeric\":[\">\",10,\"<=\",20]}]}"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -67,7 +67,7 @@ func TestAccEvidentlySegment_description(t *testing.T) {
 	description := "example description"
 	resourceName := "aws_evidently_segment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -100,7 +100,7 @@ func TestAccEvidentlySegment_patternJSON(t *testing.T) {
 	resourceName := "aws_evidently_segment.test"
 	pattern := "  {\n\t  \"Price\": [\n\t\t  {\n\t\t\t  \"numeric\": [\">\",10,\"<=\",20]\n\t\t  }\n\t  ]\n  }\n"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -132,7 +132,7 @@ func TestAccEvidentlySegment_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	resourceName := "aws_evidently_segment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
@@ -188,7 +188,7 @@ func TestAccEvidentlySegment_disappears(t *testing.T) {
 	pattern := "{\"Price\":[{\"numeric\":[\">\",10,\"<=\",20]}]}"
 	resourceName := "aws_evidently_segment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.EvidentlyEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
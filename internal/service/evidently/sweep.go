@@ -16,18 +16,18 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
 		Name: "aws_evidently_project",
 		F:    sweepProjects,
 	})
-	resource.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
 		Name: "aws_evidently_segment",
 		F:    sweepSegments,
 	})
 }
 
 func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_evidently_project")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -63,7 +63,7 @@ func sweepProjects(region string) error {
 }
 
 func sweepSegments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_evidently_segment")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
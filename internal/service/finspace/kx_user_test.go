@@ -33,7 +33,7 @@ func TestAccFinSpaceKxUser_basic(t *testing.T) {
 	userName := sdkacctest.RandString(sdkacctest.RandIntRange(1, 50))
 	resourceName := "aws_finspace_kx_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
@@ -69,7 +69,7 @@ func TestAccFinSpaceKxUser_disappears(t *testing.T) {
 	userName := sdkacctest.RandString(sdkacctest.RandIntRange(1, 50))
 	resourceName := "aws_finspace_kx_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
@@ -101,7 +101,7 @@ func TestAccFinSpaceKxUser_updateRole(t *testing.T) {
 	userName := sdkacctest.RandString(sdkacctest.RandIntRange(1, 50))
 	resourceName := "aws_finspace_kx_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
@@ -137,7 +137,7 @@ func TestAccFinSpaceKxUser_tags(t *testing.T) {
 	userName := sdkacctest.RandString(sdkacctest.RandIntRange(1, 50))
 	resourceName := "aws_finspace_kx_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
@@ -19,7 +19,7 @@ func TestAccFirehoseDeliveryStreamDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_kinesis_firehose_delivery_stream.test"
 	resourceName := "aws_kinesis_firehose_delivery_stream.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.FirehoseEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -27,7 +27,7 @@ func TestAccFISExperimentTemplate_basic(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -77,7 +77,7 @@ func TestAccFISExperimentTemplate_disappears(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -101,7 +101,7 @@ func TestAccFISExperimentTemplate_update(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -171,7 +171,7 @@ func TestAccFISExperimentTemplate_spot(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -221,7 +221,7 @@ func TestAccFISExperimentTemplate_eks(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -277,7 +277,7 @@ func TestAccFISExperimentTemplate_ebs(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -325,7 +325,7 @@ func TestAccFISExperimentTemplate_ebsParameters(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -373,7 +373,7 @@ func TestAccFISExperimentTemplate_loggingConfiguration(t *testing.T) {
 	resourceName := "aws_fis_experiment_template.test"
 	var conf types.ExperimentTemplate
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
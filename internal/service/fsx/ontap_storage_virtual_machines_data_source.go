@@ -57,7 +57,7 @@ func dataSourceONTAPStorageVirtualMachinesRead(ctx context.Context, d *schema.Re
 		svmIDs = append(svmIDs, aws.StringValue(svm.StorageVirtualMachineId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("ids", svmIDs)
 
 	return diags
@@ -23,7 +23,7 @@ func TestAccFSxONTAPStorageVirtualMachinesDataSource_Filter(t *testing.T) {
 	dataSourceName := "data.aws_fsx_ontap_storage_virtual_machines.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, fsx.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
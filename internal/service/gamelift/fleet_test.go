@@ -186,7 +186,7 @@ func TestAccGameLiftFleet_basic(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -280,7 +280,7 @@ func TestAccGameLiftFleet_tags(t *testing.T) {
 
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -361,7 +361,7 @@ func TestAccGameLiftFleet_allFields(t *testing.T) {
 	}
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -502,7 +502,7 @@ func TestAccGameLiftFleet_cert(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -542,7 +542,7 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -614,7 +614,7 @@ func TestAccGameLiftFleet_disappears(t *testing.T) {
 	params := g.Parameters(33435)
 	resourceName := "aws_gamelift_fleet.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_accelerator", name="Accelerator")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceAccelerator() *schema.Resource {
 	return &schema.Resource{
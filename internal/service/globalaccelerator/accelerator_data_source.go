@@ -19,6 +19,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourceAccelerator(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceAccelerator{}
 	d.SetMigratedFromPluginSDK(true)
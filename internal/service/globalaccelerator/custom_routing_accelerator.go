@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_custom_routing_accelerator", name="Custom Routing Accelerator")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceCustomRoutingAccelerator() *schema.Resource {
 	return &schema.Resource{
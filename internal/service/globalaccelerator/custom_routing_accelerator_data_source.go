@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_globalaccelerator_custom_routing_accelerator")
+// @Region(global=true)
 func DataSourceCustomRoutingAccelerator() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCustomRoutingAcceleratorRead,
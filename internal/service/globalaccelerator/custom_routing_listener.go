@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_custom_routing_listener")
+// @Region(global=true)
 func ResourceCustomRoutingListener() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomRoutingListenerCreate,
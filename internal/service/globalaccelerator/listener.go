@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_listener")
+// @Region(global=true)
 func ResourceListener() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerCreate,
@@ -21,7 +21,7 @@ func TestAccGlueCatalogTableDataSource_basic(t *testing.T) {
 	dbName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	tName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
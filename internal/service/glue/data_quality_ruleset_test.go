@@ -26,7 +26,7 @@ func TestAccGlueDataQualityRuleset_basic(t *testing.T) {
 	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -63,7 +63,7 @@ func TestAccGlueDataQualityRuleset_updateRuleset(t *testing.T) {
 	updatedRuleset := "Rules = [Completeness \"colA\" between 0.5 and 1.0]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -101,7 +101,7 @@ func TestAccGlueDataQualityRuleset_updateDescription(t *testing.T) {
 	updatedDescription := "updated description"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -139,7 +139,7 @@ func TestAccGlueDataQualityRuleset_targetTableRequired(t *testing.T) {
 	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -174,7 +174,7 @@ func TestAccGlueDataQualityRuleset_targetTableFull(t *testing.T) {
 	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -207,7 +207,7 @@ func TestAccGlueDataQualityRuleset_tags(t *testing.T) {
 	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -257,7 +257,7 @@ func TestAccGlueDataQualityRuleset_disappears(t *testing.T) {
 	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
 	resourceName := "aws_glue_data_quality_ruleset.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
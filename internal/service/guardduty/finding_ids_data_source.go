@@ -105,6 +105,7 @@ func findFindingIds(ctx context.Context, conn *guardduty.GuardDuty, id string) (
 }
 
 type dataSourceFindingIdsData struct {
+	framework.WithRegionModel
 	DetectorID  types.String `tfsdk:"detector_id"`
 	HasFindings types.Bool   `tfsdk:"has_findings"`
 	FindingIDs  types.List   `tfsdk:"finding_ids"`
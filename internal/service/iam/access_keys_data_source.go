@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_access_keys")
+// @Region(global=true)
 func DataSourceAccessKeys() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAccessKeysRead,
@@ -19,7 +19,7 @@ func TestAccIAMAccessKeysDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_iam_access_keys.test"
 	resourceName := "aws_iam_access_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, iam.EndpointsID)
@@ -48,7 +48,7 @@ func TestAccIAMAccessKeysDataSource_twoKeys(t *testing.T) {
 	resourceName1 := "aws_iam_access_key.test.0"
 	resourceName2 := "aws_iam_access_key.test.1"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, iam.EndpointsID)
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKResource("aws_iam_account_alias")
+// @Region(global=true)
 func ResourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAliasCreate,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_account_alias")
+// @Region(global=true)
 func DataSourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAccountAliasRead,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_iam_account_password_policy")
+// @Region(global=true)
 func ResourceAccountPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountPasswordPolicyUpdate,
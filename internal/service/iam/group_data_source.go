@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_group")
+// @Region(global=true)
 func DataSourceGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceGroupRead,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_membership")
+// @Region(global=true)
 func ResourceGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupMembershipCreate,
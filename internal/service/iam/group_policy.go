@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy")
+// @Region(global=true)
 func ResourceGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyPut,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy_attachment", name="Group Policy Attachment")
+// @Region(global=true)
 func resourceGroupPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyAttachmentCreate,
@@ -32,6 +32,7 @@ const (
 )
 
 // @SDKResource("aws_iam_instance_profile", name="Instance Profile")
+// @Region(global=true)
 // @Tags
 func ResourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
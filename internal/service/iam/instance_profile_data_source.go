@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_instance_profile")
+// @Region(global=true)
 func DataSourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInstanceProfileRead,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_instance_profiles")
+// @Region(global=true)
 func DataSourceInstanceProfiles() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInstanceProfilesRead,
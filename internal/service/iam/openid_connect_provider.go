@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_iam_openid_connect_provider", name="OIDC Provider")
+// @Region(global=true)
 // @Tags
 func ResourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
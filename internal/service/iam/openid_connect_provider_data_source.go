@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_openid_connect_provider")
+// @Region(global=true)
 func DataSourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOpenIDConnectProviderRead,
@@ -34,7 +34,8 @@ const (
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
-// @Tags
+// @Region(global=true)
+// @Tags(tagPolicyResourceType="iam:policy")
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
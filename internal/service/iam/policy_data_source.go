@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_policy")
+// @Region(global=true)
 func DataSourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyRead,
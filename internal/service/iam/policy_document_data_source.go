@@ -22,6 +22,7 @@ import (
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
 // @SDKDataSource("aws_iam_policy_document")
+// @Region(global=true)
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_principal_policy_simulation")
+// @Region(global=true)
 func DataSourcePrincipalPolicySimulation() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePrincipalPolicySimulationRead,
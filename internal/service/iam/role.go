@@ -40,7 +40,9 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
-// @Tags
+// @IdentityAttribute("name", idAttrShadows=true)
+// @Region(global=true)
+// @Tags(tagPolicyResourceType="iam:role")
 func ResourceRole() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoleCreate,
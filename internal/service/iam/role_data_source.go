@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_role")
+// @Region(global=true)
 func DataSourceRole() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRoleRead,
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_iam_role", name="Role")
+func newRoleListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	framework.ListResourceWithConfigure
+	framework.WithSDKv2ResourceList
+}
+
+func (l *roleListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list roles whose paths start with this prefix.",
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().IAMConn(ctx)
+
+	input := &iam.ListRolesInput{
+		PathPrefix: fwflex.StringFromFramework(ctx, data.PathPrefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := conn.ListRolesPagesWithContext(ctx, input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Roles {
+				if v == nil {
+					continue
+				}
+
+				name := aws.StringValue(v.RoleName)
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, l.Meta(), request.IncludeResource, d, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError(create.ProblemStandardMessage(names.IAM, create.ErrActionListing, "Roles", "", nil), err.Error())
+			yield(result)
+		}
+	}
+}
+
+type roleListResourceModel struct {
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
@@ -30,6 +30,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role_policy")
+// @Region(global=true)
 func ResourceRolePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyPut,
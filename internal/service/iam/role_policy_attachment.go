@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_role_policy_attachment", name="Role Policy Attachment")
+// @Region(global=true)
 func resourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyAttachmentCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_roles")
+// @Region(global=true)
 func DataSourceRoles() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRolesRead,
@@ -81,7 +82,7 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading IAM roles: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 
 	var arns, names []string
 
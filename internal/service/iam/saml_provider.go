@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_iam_saml_provider", name="SAML Provider")
+// @Region(global=true)
 // @Tags
 func ResourceSAMLProvider() *schema.Resource {
 	return &schema.Resource{
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_saml_provider")
+// @Region(global=true)
 func DataSourceSAMLProvider() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSAMLProviderRead,
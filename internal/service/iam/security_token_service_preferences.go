@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKResource("aws_iam_security_token_service_preferences", name="Security Token Service Preferences")
+// @Region(global=true)
 func ResourceSecurityTokenServicePreferences() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSecurityTokenServicePreferencesUpsert,
@@ -15,7 +15,7 @@ func TestAccIAMSecurityTokenServicePreferences_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_iam_security_token_service_preferences.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
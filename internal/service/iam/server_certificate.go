@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_iam_server_certificate", name="Server Certificate")
+// @Region(global=true)
 // @Tags
 func ResourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_server_certificate")
+// @Region(global=true)
 func DataSourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServerCertificateRead,
@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_iam_service_linked_role", name="Service Linked Role")
+// @Region(global=true)
 // @Tags
 func ResourceServiceLinkedRole() *schema.Resource {
 	return &schema.Resource{
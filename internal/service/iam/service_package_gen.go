@@ -23,75 +23,136 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	return []*types.ServicePackageFrameworkResource{}
 }
 
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newRoleListResource,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
 			Factory:  DataSourceAccessKeys,
 			TypeName: "aws_iam_access_keys",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceAccountAlias,
 			TypeName: "aws_iam_account_alias",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceGroup,
 			TypeName: "aws_iam_group",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceInstanceProfiles,
 			TypeName: "aws_iam_instance_profiles",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOpenIDConnectProvider,
 			TypeName: "aws_iam_openid_connect_provider",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePolicy,
 			TypeName: "aws_iam_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceRole,
 			TypeName: "aws_iam_role",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceRoles,
 			TypeName: "aws_iam_roles",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceSessionContext,
 			TypeName: "aws_iam_session_context",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceUser,
 			TypeName: "aws_iam_user",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceUsers,
 			TypeName: "aws_iam_users",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -101,133 +162,230 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceAccessKey,
 			TypeName: "aws_iam_access_key",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceAccountAlias,
 			TypeName: "aws_iam_account_alias",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceAccountPasswordPolicy,
 			TypeName: "aws_iam_account_password_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceGroup,
 			TypeName: "aws_iam_group",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  resourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
 			Name:     "Instance Profile",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourceOpenIDConnectProvider,
 			TypeName: "aws_iam_openid_connect_provider",
 			Name:     "OIDC Provider",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourcePolicy,
 			TypeName: "aws_iam_policy",
 			Name:     "Policy",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{
+				PolicyResourceType: "iam:policy",
+			},
 		},
 		{
 			Factory:  resourcePolicyAttachment,
 			TypeName: "aws_iam_policy_attachment",
 			Name:     "Policy Attachment",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceRole,
 			TypeName: "aws_iam_role",
 			Name:     "Role",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{
+				PolicyResourceType: "iam:role",
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "name",
+						Required: true,
+					},
+				},
+				IsGlobalResource:  true,
+				IDAttrShadowsAttr: "name",
+			},
 		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  resourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
 			Name:     "SAML Provider",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourceSecurityTokenServicePreferences,
 			TypeName: "aws_iam_security_token_service_preferences",
 			Name:     "Security Token Service Preferences",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
 			Name:     "Server Certificate",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourceServiceLinkedRole,
 			TypeName: "aws_iam_service_linked_role",
 			Name:     "Service Linked Role",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourceServiceSpecificCredential,
 			TypeName: "aws_iam_service_specific_credential",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceSigningCertificate,
 			TypeName: "aws_iam_signing_certificate",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceUser,
 			TypeName: "aws_iam_user",
 			Name:     "User",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{
+				PolicyResourceType: "iam:user",
+			},
 		},
 		{
 			Factory:  ResourceUserGroupMembership,
 			TypeName: "aws_iam_user_group_membership",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  resourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceVirtualMFADevice,
 			TypeName: "aws_iam_virtual_mfa_device",
 			Name:     "Virtual MFA Device",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 	}
 }
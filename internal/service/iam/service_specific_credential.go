@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_iam_service_specific_credential")
+// @Region(global=true)
 func ResourceServiceSpecificCredential() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServiceSpecificCredentialCreate,
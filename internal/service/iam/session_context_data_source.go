@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_session_context")
+// @Region(global=true)
 func DataSourceSessionContext() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSessionContextRead,
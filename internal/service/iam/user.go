@@ -27,7 +27,8 @@ import (
 )
 
 // @SDKResource("aws_iam_user", name="User")
-// @Tags
+// @Region(global=true)
+// @Tags(tagPolicyResourceType="iam:user")
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
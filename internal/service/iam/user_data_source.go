@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_user")
+// @Region(global=true)
 func DataSourceUser() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceUserRead,
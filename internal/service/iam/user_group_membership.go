@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_group_membership")
+// @Region(global=true)
 func ResourceUserGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupMembershipCreate,
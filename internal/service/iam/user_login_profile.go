@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_login_profile")
+// @Region(global=true)
 func ResourceUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserLoginProfileCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy")
+// @Region(global=true)
 func ResourceUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyPut,
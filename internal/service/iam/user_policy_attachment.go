@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy_attachment", name="User Policy Attachment")
+// @Region(global=true)
 func resourceUserPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyAttachmentCreate,
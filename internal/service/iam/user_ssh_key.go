@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_ssh_key")
+// @Region(global=true)
 func ResourceUserSSHKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserSSHKeyCreate,
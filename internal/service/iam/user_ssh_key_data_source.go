@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_user_ssh_key")
+// @Region(global=true)
 func DataSourceUserSSHKey() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceUserSSHKeyRead,
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_iam_virtual_mfa_device", name="Virtual MFA Device")
+// @Region(global=true)
 // @Tags
 func ResourceVirtualMFADevice() *schema.Resource {
 	return &schema.Resource{
@@ -33,7 +33,7 @@ func TestAccIdentityStoreGroupMembership_basic(t *testing.T) {
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // Group Name
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // User Name
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
@@ -71,7 +71,7 @@ func TestAccIdentityStoreGroupMembership_disappears(t *testing.T) {
 
 	resourceName := "aws_identitystore_group_membership.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
@@ -104,7 +104,7 @@ func TestAccIdentityStoreGroupMembership_GroupId(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // Group Name 2
 	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // User Name
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
@@ -148,7 +148,7 @@ func TestAccIdentityStoreGroupMembership_MemberId(t *testing.T) {
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // User Name 1
 	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix) // User Name 2
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
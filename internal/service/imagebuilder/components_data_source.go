@@ -84,7 +84,7 @@ func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
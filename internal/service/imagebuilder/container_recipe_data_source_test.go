@@ -19,7 +19,7 @@ func TestAccImageBuilderContainerRecipeDataSource_arn(t *testing.T) {
 	dataSourceName := "data.aws_imagebuilder_container_recipe.test"
 	resourceName := "aws_imagebuilder_container_recipe.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
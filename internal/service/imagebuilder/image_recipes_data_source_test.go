@@ -19,7 +19,7 @@ func TestAccImageBuilderImageRecipesDataSource_owner(t *testing.T) {
 	dataSourceNameOwnerAmazon := "data.aws_imagebuilder_image_recipes.amazon"
 	dataSourceNameOwnerSelf := "data.aws_imagebuilder_image_recipes.self"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -44,7 +44,7 @@ func TestAccImageBuilderImageRecipesDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_imagebuilder_image_recipes.test"
 	resourceName := "aws_imagebuilder_image_recipe.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
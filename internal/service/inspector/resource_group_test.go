@@ -22,7 +22,7 @@ func TestAccInspectorResourceGroup_basic(t *testing.T) {
 	var v1, v2 inspector.ResourceGroup
 	resourceName := "aws_inspector_resource_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, inspector.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -197,7 +197,7 @@ func resourceIndexingConfigurationPut(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "updating IoT Indexing Configuration: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 
 	return append(diags, resourceIndexingConfigurationRead(ctx, d, meta)...)
 }
@@ -26,7 +26,7 @@ func TestAccIoTPolicyAttachment_basic(t *testing.T) {
 	resource2Name := "aws_iot_policy_attachment.test2"
 	resource3Name := "aws_iot_policy_attachment.test3"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -27,7 +27,7 @@ func TestAccIoTRoleAlias_basic(t *testing.T) {
 	resourceName := "aws_iot_role_alias.ra"
 	resourceName2 := "aws_iot_role_alias.ra2"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -29,7 +29,7 @@ func TestAccIVSChannel_basic(t *testing.T) {
 
 	resourceName := "aws_ivs_channel.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVS)
@@ -65,7 +65,7 @@ func TestAccIVSChannel_tags(t *testing.T) {
 
 	resourceName := "aws_ivs_channel.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVS)
@@ -119,7 +119,7 @@ func TestAccIVSChannel_update(t *testing.T) {
 	latencyMode := "NORMAL"
 	channelType := "BASIC"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVS)
@@ -161,7 +161,7 @@ func TestAccIVSChannel_disappears(t *testing.T) {
 
 	resourceName := "aws_ivs_channel.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
@@ -190,7 +190,7 @@ func TestAccIVSChannel_recordingConfiguration(t *testing.T) {
 	resourceName := "aws_ivs_channel.test"
 	recordingConfigurationResourceName := "aws_ivs_recording_configuration.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
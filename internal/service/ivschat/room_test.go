@@ -28,7 +28,7 @@ func TestAccIVSChatRoom_basic(t *testing.T) {
 	var room ivschat.GetRoomOutput
 	resourceName := "aws_ivschat_room.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
@@ -62,7 +62,7 @@ func TestAccIVSChatRoom_tags(t *testing.T) {
 	var room ivschat.GetRoomOutput
 	resourceName := "aws_ivschat_room.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
@@ -111,7 +111,7 @@ func TestAccIVSChatRoom_disappears(t *testing.T) {
 	var room ivschat.GetRoomOutput
 	resourceName := "aws_ivschat_room.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
@@ -142,7 +142,7 @@ func TestAccIVSChatRoom_update(t *testing.T) {
 	maximumMessageLength := "123"
 	maximumMessageRatePerSecond := "5"
 	fallbackResult := "DENY"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
@@ -185,7 +185,7 @@ func TestAccIVSChatRoom_loggingConfiguration(t *testing.T) {
 
 	resourceName := "aws_ivschat_room.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
@@ -245,7 +245,7 @@ func TestAccIVSChatRoom_update_remove_messageReviewHandler_uri(t *testing.T) {
 
 	resourceName := "aws_ivschat_room.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
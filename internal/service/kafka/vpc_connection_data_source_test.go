@@ -18,7 +18,7 @@ func TestAccKafkaVPCConnectionDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_msk_vpc_connection.test"
 	resourceName := "aws_msk_vpc_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.Kafka)
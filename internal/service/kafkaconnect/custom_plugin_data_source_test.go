@@ -19,7 +19,7 @@ func TestAccKafkaConnectCustomPluginDataSource_basic(t *testing.T) {
 	resourceName := "aws_mskconnect_custom_plugin.test"
 	dataSourceName := "data.aws_mskconnect_custom_plugin.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, kafkaconnect.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
 		CheckDestroy:             nil,
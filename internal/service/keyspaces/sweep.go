@@ -16,14 +16,14 @@ import (
 
 func RegisterSweepers() {
 	// No need to have separate sweeper for table as would be destroyed as part of keyspace
-	resource.AddTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
 		Name: "aws_keyspaces_keyspace",
 		F:    sweepKeyspaces,
 	})
 }
 
 func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-name
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_keyspaces_keyspace")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -26,6 +26,7 @@ import (
 )
 
 // @FrameworkResource(name="Resource Policy")
+// @IdentityAttribute("resource_arn", idAttrShadows=true)
 func newResourcePolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourcePolicyResource{}
 
@@ -34,7 +35,7 @@ func newResourcePolicyResource(context.Context) (resource.ResourceWithConfigure,
 
 type resourcePolicyResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *resourcePolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -212,6 +213,7 @@ func findResourcePolicyByResourceARN(ctx context.Context, conn *kinesis.Client,
 }
 
 type resourcePolicyResourceModel struct {
+	framework.WithRegionModel
 	ID          types.String      `tfsdk:"id"`
 	Policy      fwtypes.IAMPolicy `tfsdk:"policy"`
 	ResourceARN fwtypes.ARN       `tfsdk:"resource_arn"`
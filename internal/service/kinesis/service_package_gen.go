@@ -21,6 +21,15 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourcePolicyResource,
 			Name:    "Resource Policy",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "resource_arn",
+						Required: true,
+					},
+				},
+				IDAttrShadowsAttr: "resource_arn",
+			},
 		},
 	}
 }
@@ -47,6 +56,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Stream",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "name",
+				PolicyResourceType:  "kinesis:stream",
 			},
 		},
 		{
@@ -30,7 +30,7 @@ import (
 )
 
 // @SDKResource("aws_kinesis_stream", name="Stream")
-// @Tags(identifierAttribute="name")
+// @Tags(identifierAttribute="name", tagPolicyResourceType="kinesis:stream")
 func resourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
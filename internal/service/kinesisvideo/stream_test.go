@@ -29,7 +29,7 @@ func TestAccKinesisVideoStream_basic(t *testing.T) {
 	rInt1 := sdkacctest.RandInt()
 	rInt2 := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, kinesisvideo.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -72,7 +72,7 @@ func TestAccKinesisVideoStream_options(t *testing.T) {
 	rName1 := sdkacctest.RandString(8)
 	rName2 := sdkacctest.RandString(8)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, kinesisvideo.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -115,7 +115,7 @@ func TestAccKinesisVideoStream_tags(t *testing.T) {
 	resourceName := "aws_kinesis_video_stream.default"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, kinesisvideo.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -162,7 +162,7 @@ func TestAccKinesisVideoStream_disappears(t *testing.T) {
 	resourceName := "aws_kinesis_video_stream.default"
 	rInt := sdkacctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, kinesisvideo.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -30,7 +30,7 @@ func TestAccKMSCustomKeyStoreDataSource_basic(t *testing.T) {
 	clusterId := os.Getenv("CLOUD_HSM_CLUSTER_ID")
 	trustAnchorCertificate := os.Getenv("TRUST_ANCHOR_CERTIFICATE")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
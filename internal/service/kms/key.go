@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_kms_key", name="Key")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="kms:key")
 func ResourceKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyCreate,
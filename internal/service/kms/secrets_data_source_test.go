@@ -26,7 +26,7 @@ func TestAccKMSSecretsDataSource_basic(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 
 	// Run a resource test to setup our KMS key
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -53,7 +53,7 @@ func TestAccKMSSecretsDataSource_asymmetric(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 
 	// Run a resource test to setup our KMS key
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -24,7 +24,7 @@ func TestAccLambdaCodeSigningConfig_basic(t *testing.T) {
 	signingProfile2 := "aws_signer_signing_profile.test2"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -55,7 +55,7 @@ func TestAccLambdaCodeSigningConfig_updatePolicy(t *testing.T) {
 	resourceName := "aws_lambda_code_signing_config.code_signing_config"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -92,7 +92,7 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 	signingProfile2 := "aws_signer_signing_profile.test2"
 	var conf lambda.GetCodeSigningConfigOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_lambda_function", name="Function")
+func newFunctionListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &functionListResource{}, nil
+}
+
+type functionListResource struct {
+	framework.ListResourceWithConfigure
+	framework.WithSDKv2ResourceList
+}
+
+func (l *functionListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lambda_function"
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().LambdaClient(ctx)
+
+	input := &lambda.ListFunctionsInput{}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError(create.ProblemStandardMessage(names.Lambda, create.ErrActionListing, "Functions", "", nil), err.Error())
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Functions {
+				name := aws.ToString(v.FunctionName)
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set("function_name", name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, l.Meta(), request.IncludeResource, d, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -70,7 +70,7 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameFunctions, "", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("function_arns", functionARNs)
 	d.Set("function_names", functionNames)
 
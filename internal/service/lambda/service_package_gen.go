@@ -25,6 +25,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	return []*types.ServicePackageFrameworkResource{}
 }
 
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newFunctionListResource,
+			TypeName: "aws_lambda_function",
+			Name:     "Function",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -78,6 +88,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Function",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
+				PolicyResourceType:  "lambda:function",
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "function_name",
+						Required: true,
+					},
+				},
+				IDAttrShadowsAttr: "function_name",
 			},
 		},
 		{
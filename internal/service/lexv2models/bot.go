@@ -202,7 +202,7 @@ func (r *resourceBot) Create(ctx context.Context, req resource.CreateRequest, re
 	botArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "lex",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().RegionForContext(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("bot/%s", aws.ToString(out.BotId)),
 	}.String()
@@ -248,7 +248,7 @@ func (r *resourceBot) Read(ctx context.Context, req resource.ReadRequest, resp *
 	botArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "lex",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().RegionForContext(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("bot/%s", aws.ToString(out.BotId)),
 	}.String()
@@ -595,6 +595,7 @@ func (rd *resourceBotData) refreshFromOutput(ctx context.Context, out *lexmodels
 }
 
 type resourceBotData struct {
+	framework.WithRegionModel
 	ARN                     types.String   `tfsdk:"arn"`
 	DataPrivacy             types.List     `tfsdk:"data_privacy"`
 	Description             types.String   `tfsdk:"description"`
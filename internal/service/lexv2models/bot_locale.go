@@ -501,6 +501,7 @@ func expandVoiceSettings(ctx context.Context, tfList []voiceSettingsData) *awsty
 }
 
 type resourceBotLocaleData struct {
+	framework.WithRegionModel
 	BotID                        types.String   `tfsdk:"bot_id"`
 	BotVersion                   types.String   `tfsdk:"bot_version"`
 	LocaleID                     types.String   `tfsdk:"locale_id"`
@@ -30,7 +30,7 @@ func TestAccLexV2ModelsBotLocale_basic(t *testing.T) {
 	resourceName := "aws_lexv2models_bot_locale.test"
 	botResourceName := "aws_lexv2models_bot.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
@@ -66,7 +66,7 @@ func TestAccLexV2ModelsBotLocale_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lexv2models_bot_locale.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
@@ -97,7 +97,7 @@ func TestAccLexV2ModelsBotLocale_voiceSettings(t *testing.T) {
 	// https://docs.aws.amazon.com/polly/latest/dg/voicelist.html
 	voiceID := "Kendra"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
@@ -338,6 +338,7 @@ func expandLocalSpecification(tfMap map[string]versionLocaleDetailsData) map[str
 }
 
 type resourceBotVersionData struct {
+	framework.WithRegionModel
 	LocaleSpecification types.Map      `tfsdk:"locale_specification"`
 	Description         types.String   `tfsdk:"description"`
 	BotID               types.String   `tfsdk:"bot_id"`
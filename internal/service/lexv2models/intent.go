@@ -908,7 +908,7 @@ func (r *resourceIntent) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	in := &lexmodelsv2.CreateIntentInput{}
-	resp.Diagnostics.Append(flex.Expand(context.WithValue(ctx, flex.ResourcePrefix, ResNameIntent), &data, in)...)
+	resp.Diagnostics.Append(flex.Expand(ctx, &data, in, flex.WithFieldNamePrefix(ResNameIntent))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -943,7 +943,7 @@ func (r *resourceIntent) Create(ctx context.Context, req resource.CreateRequest,
 
 	// get some data from the intent
 	var dataAfter ResourceIntentData
-	resp.Diagnostics.Append(flex.Flatten(context.WithValue(ctx, flex.ResourcePrefix, ResNameIntent), intent, &dataAfter)...)
+	resp.Diagnostics.Append(flex.Flatten(ctx, intent, &dataAfter, flex.WithFieldNamePrefix(ResNameIntent))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -984,7 +984,7 @@ func (r *resourceIntent) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	resp.Diagnostics.Append(flex.Flatten(context.WithValue(ctx, flex.ResourcePrefix, ResNameIntent), out, &data)...)
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data, flex.WithFieldNamePrefix(ResNameIntent))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1052,7 +1052,7 @@ func (r *resourceIntent) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	input := &lexmodelsv2.UpdateIntentInput{}
-	resp.Diagnostics.Append(flex.Expand(context.WithValue(ctx, flex.ResourcePrefix, ResNameIntent), &new, input)...)
+	resp.Diagnostics.Append(flex.Expand(ctx, &new, input, flex.WithFieldNamePrefix(ResNameIntent))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1495,6 +1495,7 @@ type DialogCodeHookSettings struct {
 }
 
 type ResourceIntentData struct {
+	framework.WithRegionModel
 	BotID                  types.String                                                 `tfsdk:"bot_id"`
 	BotVersion             types.String                                                 `tfsdk:"bot_version"`
 	ClosingSetting         fwtypes.ListNestedObjectValueOf[IntentClosingSetting]        `tfsdk:"closing_setting"`
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
 		Name: "aws_lexv2models_bot",
 		F:    sweepBots,
 	})
 }
 
 func sweepBots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_lexv2models_bot")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
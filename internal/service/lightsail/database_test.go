@@ -30,7 +30,7 @@ func TestAccLightsailDatabase_basic(t *testing.T) {
 	resourceName := "aws_lightsail_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -87,7 +87,7 @@ func TestAccLightsailDatabase_relationalDatabaseName(t *testing.T) {
 	rNameStartingDash := fmt.Sprintf("%s-%s", "-", rName)
 	rNameEndingDash := fmt.Sprintf("%s-%s", rName, "-")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -150,7 +150,7 @@ func TestAccLightsailDatabase_masterDatabaseName(t *testing.T) {
 	dbNameContainsStartingDigit := fmt.Sprintf("01_%s", dbName)
 	dbNameContainsUnderscore := fmt.Sprintf("%s_123456", dbName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -217,7 +217,7 @@ func TestAccLightsailDatabase_masterUsername(t *testing.T) {
 	usernameContainsSpecial := fmt.Sprintf("%s@", username)
 	usernameContainsUndercore := fmt.Sprintf("%s_test", username)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -287,7 +287,7 @@ func TestAccLightsailDatabase_masterPassword(t *testing.T) {
 	passwordContainsAtSymbol := fmt.Sprintf("%s@", password)
 	passwordContainsSpaces := fmt.Sprintf("%s spaces here", password)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -332,7 +332,7 @@ func TestAccLightsailDatabase_preferredBackupWindow(t *testing.T) {
 	backupWindowInvalidHour := "25:30-10:00"
 	backupWindowInvalidMinute := "10:00-10:70"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -387,7 +387,7 @@ func TestAccLightsailDatabase_preferredMaintenanceWindow(t *testing.T) {
 	maintenanceWindowInvalidHour := "tue:04:30-tue:30:00"
 	maintenanceWindowInvalidMinute := "tue:04:85-tue:05:00"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -443,7 +443,7 @@ func TestAccLightsailDatabase_publiclyAccessible(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -487,7 +487,7 @@ func TestAccLightsailDatabase_backupRetentionEnabled(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -536,7 +536,7 @@ func TestAccLightsailDatabase_finalSnapshotName(t *testing.T) {
 	sNameContainsSpaces := fmt.Sprint(sName, "string with spaces")
 	sNameContainsUnderscore := fmt.Sprintf("%s_123456", sName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -588,7 +588,7 @@ func TestAccLightsailDatabase_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -643,7 +643,7 @@ func TestAccLightsailDatabase_ha(t *testing.T) {
 	resourceName := "aws_lightsail_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -701,7 +701,7 @@ func TestAccLightsailDatabase_disappears(t *testing.T) {
 		return nil
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
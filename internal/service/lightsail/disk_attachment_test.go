@@ -31,7 +31,7 @@ func TestAccLightsailDiskAttachment_basic(t *testing.T) {
 	diskPath := "/dev/xvdf"
 	diskPathBad := "/jenkins-home"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -65,7 +65,7 @@ func TestAccLightsailDiskAttachment_disappears(t *testing.T) {
 	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
 	diskPath := "/dev/xvdf"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
@@ -19,7 +19,7 @@ func TestAccLocationGeofenceCollectionDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_location_geofence_collection.test"
 	resourceName := "aws_location_geofence_collection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
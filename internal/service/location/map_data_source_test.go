@@ -19,7 +19,7 @@ func TestAccLocationMapDataSource_mapName(t *testing.T) {
 	dataSourceName := "data.aws_location_map.test"
 	resourceName := "aws_location_map.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -26,7 +26,8 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
-// @Tags
+// @IdentityAttribute("name", idAttrShadows=true)
+// @Tags(tagPolicyResourceType="logs:log-group")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
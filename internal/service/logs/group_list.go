@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_cloudwatch_log_group", name="Log Group")
+func newGroupListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &groupListResource{}, nil
+}
+
+type groupListResource struct {
+	framework.ListResourceWithConfigure
+	framework.WithSDKv2ResourceList
+}
+
+func (l *groupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudwatch_log_group"
+}
+
+func (l *groupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"log_group_name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list log groups whose names start with this prefix.",
+			},
+		},
+	}
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data groupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: fwflex.StringFromFramework(ctx, data.LogGroupNamePrefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError(create.ProblemStandardMessage(names.Logs, create.ErrActionListing, "Log Groups", "", nil), err.Error())
+				yield(result)
+				return
+			}
+
+			for _, v := range page.LogGroups {
+				name := aws.ToString(v.LogGroupName)
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, l.Meta(), request.IncludeResource, d, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type groupListResourceModel struct {
+	LogGroupNamePrefix types.String `tfsdk:"log_group_name_prefix"`
+	Region             types.String `tfsdk:"region"`
+}
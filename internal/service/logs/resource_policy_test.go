@@ -25,7 +25,7 @@ func TestAccLogsResourcePolicy_basic(t *testing.T) {
 	resourceName := "aws_cloudwatch_log_resource_policy.test"
 	var resourcePolicy types.ResourcePolicy
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -62,7 +62,7 @@ func TestAccLogsResourcePolicy_ignoreEquivalent(t *testing.T) {
 	resourceName := "aws_cloudwatch_log_resource_policy.test"
 	var resourcePolicy types.ResourcePolicy
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -15,14 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
 }
 
 func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_media_package_channel")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -26,7 +26,7 @@ func TestAccMediaStoreContainerPolicy_basic(t *testing.T) {
 
 	rName = strings.ReplaceAll(rName, "-", "_")
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, mediastore.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
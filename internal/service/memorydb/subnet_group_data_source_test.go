@@ -19,7 +19,7 @@ func TestAccMemoryDBSubnetGroupDataSource_basic(t *testing.T) {
 	resourceName := "aws_memorydb_subnet_group.test"
 	dataSourceName := "data.aws_memorydb_subnet_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, memorydb.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -14,6 +14,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourceBillingServiceAccount(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceBillingServiceAccount{}
 	d.SetMigratedFromPluginSDK(true)
@@ -16,7 +16,7 @@ func TestAccMetaBillingServiceAccountDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_billing_service_account.test"
 	billingAccountID := "386209384616"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -17,6 +17,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourceDefaultTags(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceDefaultTags{}
 	d.SetMigratedFromPluginSDK(true)
@@ -24,6 +24,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourceIPRanges(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceIPRanges{}
 	d.SetMigratedFromPluginSDK(true)
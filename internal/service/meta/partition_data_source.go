@@ -13,6 +13,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourcePartition(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourcePartition{}
 	d.SetMigratedFromPluginSDK(true)
@@ -17,6 +17,7 @@ import (
 )
 
 // @FrameworkDataSource(name=Regions)
+// @Region(global=true)
 func newDataSourceRegions(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceRegions{}
 	d.SetMigratedFromPluginSDK(true)
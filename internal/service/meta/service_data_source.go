@@ -116,7 +116,7 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	if data.Region.IsNull() {
-		data.Region = types.StringValue(d.Meta().Region)
+		data.Region = types.StringValue(d.Meta().RegionForContext(ctx))
 	}
 
 	if data.ServiceID.IsNull() {
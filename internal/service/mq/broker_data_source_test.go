@@ -25,7 +25,7 @@ func TestAccMQBrokerDataSource_basic(t *testing.T) {
 	dataSourceByIdName := "data.aws_mq_broker.by_id"
 	dataSourceByNameName := "data.aws_mq_broker.by_name"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MQEndpointID) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
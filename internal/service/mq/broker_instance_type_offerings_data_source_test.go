@@ -13,7 +13,7 @@ import (
 
 func TestAccMQBrokerInstanceTypeOfferingsDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MQEndpointID) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
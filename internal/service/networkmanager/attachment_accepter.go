@@ -23,6 +23,7 @@ import (
 // To facilitate querying and waiters on specific attachment types, attachment_type set to required
 
 // @SDKResource("aws_networkmanager_attachment_accepter")
+// @Region(global=true)
 func ResourceAttachmentAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAttachmentAccepterCreate,
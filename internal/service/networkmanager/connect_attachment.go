@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connect_attachment", name="Connect Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceConnectAttachment() *schema.Resource {
 	return &schema.Resource{
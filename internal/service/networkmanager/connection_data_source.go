@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_connection")
+// @Region(global=true)
 func DataSourceConnection() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceConnectionRead,
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_customer_gateway_association")
+// @Region(global=true)
 func ResourceCustomerGatewayAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerGatewayAssociationCreate,
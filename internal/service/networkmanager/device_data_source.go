@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_device")
+// @Region(global=true)
 func DataSourceDevice() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDeviceRead,
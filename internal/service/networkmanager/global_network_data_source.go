@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_global_network")
+// @Region(global=true)
 func DataSourceGlobalNetwork() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceGlobalNetworkRead,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_global_networks")
+// @Region(global=true)
 func DataSourceGlobalNetworks() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceGlobalNetworksRead,
@@ -56,7 +57,7 @@ func dataSourceGlobalNetworksRead(ctx context.Context, d *schema.ResourceData, m
 		globalNetworkIDs = append(globalNetworkIDs, aws.StringValue(v.GlobalNetworkId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("ids", globalNetworkIDs)
 
 	return diags
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_link", name="Link")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceLink() *schema.Resource {
 	return &schema.Resource{
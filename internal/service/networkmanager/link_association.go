@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_link_association")
+// @Region(global=true)
 func ResourceLinkAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLinkAssociationCreate,
@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_link")
+// @Region(global=true)
 func DataSourceLink() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceLinkRead,
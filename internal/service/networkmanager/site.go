@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_site", name="Site")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceSite() *schema.Resource {
 	return &schema.Resource{
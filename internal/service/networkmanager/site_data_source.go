@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_site")
+// @Region(global=true)
 func DataSourceSite() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSiteRead,
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_site_to_site_vpn_attachment", name="Site To Site VPN Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceSiteToSiteVPNAttachment() *schema.Resource {
 	return &schema.Resource{
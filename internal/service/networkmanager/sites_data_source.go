@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_sites")
+// @Region(global=true)
 func DataSourceSites() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSitesRead,
@@ -62,7 +63,7 @@ func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		siteIDs = append(siteIDs, aws.StringValue(v.SiteId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).RegionForContext(ctx))
 	d.Set("ids", siteIDs)
 
 	return diags
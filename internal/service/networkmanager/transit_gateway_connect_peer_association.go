@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_connect_peer_association")
+// @Region(global=true)
 func ResourceTransitGatewayConnectPeerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayConnectPeerAssociationCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_registration")
+// @Region(global=true)
 func ResourceTransitGatewayRegistration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayRegistrationCreate,
@@ -53,7 +53,7 @@ func TestAccNetworkManagerVPCAttachment_basic(t *testing.T) {
 			var v networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -121,7 +121,7 @@ func TestAccNetworkManagerVPCAttachment_Attached_basic(t *testing.T) {
 			var v networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -187,7 +187,7 @@ func TestAccNetworkManagerVPCAttachment_disappears(t *testing.T) {
 			var v networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -240,7 +240,7 @@ func TestAccNetworkManagerVPCAttachment_Attached_disappears(t *testing.T) { // n
 			var v networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -276,7 +276,7 @@ func TestAccNetworkManagerVPCAttachment_Attached_disappearsAccepter(t *testing.T
 	var v networkmanager.VpcAttachment
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -309,7 +309,7 @@ func TestAccNetworkManagerVPCAttachment_tags(t *testing.T) {
 	var v networkmanager.VpcAttachment
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -382,7 +382,7 @@ func TestAccNetworkManagerVPCAttachment_update(t *testing.T) {
 			var v1, v2, v3, v4 networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -468,7 +468,7 @@ func TestAccNetworkManagerVPCAttachment_Attached_update(t *testing.T) {
 			var v1, v2, v3, v4 networkmanager.VpcAttachment
 			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-			resource.ParallelTest(t, resource.TestCase{
+			acctest.ParallelTest(t, resource.TestCase{
 				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 				ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
 				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -21,7 +21,7 @@ func TestAccOpenSearchOutboundConnection_basic(t *testing.T) {
 	name := fmt.Sprintf("tf-test-%s", ri)
 	resourceName := "aws_opensearch_outbound_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -52,7 +52,7 @@ func TestAccOpenSearchOutboundConnection_vpc(t *testing.T) {
 	name := fmt.Sprintf("tf-test-%s", ri)
 	resourceName := "aws_opensearch_outbound_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -84,7 +84,7 @@ func TestAccOpenSearchOutboundConnection_disappears(t *testing.T) {
 	name := fmt.Sprintf("tf-test-%s", ri)
 	resourceName := "aws_opensearch_outbound_connection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
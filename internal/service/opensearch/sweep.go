@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
 		Name: "aws_opensearch_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -25,19 +25,19 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
 		Name: "aws_opensearch_inbound_connection_accepter",
 		F:    sweepInboundConnections,
 	})
 
-	resource.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
 		Name: "aws_opensearch_outbound_connection",
 		F:    sweepOutboundConnections,
 	})
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opensearch_domain")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -118,7 +118,7 @@ func sweepDomains(region string) error {
 }
 
 func sweepInboundConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opensearch_inbound_connection_accepter")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -171,7 +171,7 @@ func sweepInboundConnections(region string) error {
 }
 
 func sweepOutboundConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opensearch_outbound_connection")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -36,6 +36,7 @@ func newResourceAccessPolicy(_ context.Context) (resource.ResourceWithConfigure,
 }
 
 type resourceAccessPolicyData struct {
+	framework.WithRegionModel
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
@@ -104,6 +104,7 @@ func (d *dataSourceAccessPolicy) Read(ctx context.Context, req datasource.ReadRe
 }
 
 type dataSourceAccessPolicyData struct {
+	framework.WithRegionModel
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
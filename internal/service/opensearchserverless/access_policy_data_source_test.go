@@ -22,7 +22,7 @@ func TestAccOpenSearchServerlessAccessPolicyDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_opensearchserverless_access_policy.test"
 	resourceName := "aws_opensearchserverless_access_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
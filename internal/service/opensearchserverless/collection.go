@@ -44,6 +44,7 @@ func newResourceCollection(_ context.Context) (resource.ResourceWithConfigure, e
 }
 
 type resourceCollectionData struct {
+	framework.WithRegionModel
 	ARN                types.String   `tfsdk:"arn"`
 	CollectionEndpoint types.String   `tfsdk:"collection_endpoint"`
 	DashboardEndpoint  types.String   `tfsdk:"dashboard_endpoint"`
@@ -163,6 +163,7 @@ func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 type dataSourceCollectionData struct {
+	framework.WithRegionModel
 	ARN                types.String `tfsdk:"arn"`
 	CollectionEndpoint types.String `tfsdk:"collection_endpoint"`
 	CreatedDate        types.String `tfsdk:"created_date"`
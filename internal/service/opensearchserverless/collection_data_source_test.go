@@ -25,7 +25,7 @@ func TestAccOpenSearchServerlessCollectionDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_opensearchserverless_collection.test"
 	resourceName := "aws_opensearchserverless_collection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
@@ -65,7 +65,7 @@ func TestAccOpenSearchServerlessCollectionDataSource_name(t *testing.T) {
 	dataSourceName := "data.aws_opensearchserverless_collection.test"
 	resourceName := "aws_opensearchserverless_collection.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
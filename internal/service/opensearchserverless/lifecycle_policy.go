@@ -288,6 +288,7 @@ func (rd *resourceLifecyclePolicyData) refreshFromOutput(ctx context.Context, ou
 }
 
 type resourceLifecyclePolicyData struct {
+	framework.WithRegionModel
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
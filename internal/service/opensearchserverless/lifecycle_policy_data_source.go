@@ -119,6 +119,7 @@ func (d *dataSourceLifecyclePolicy) Read(ctx context.Context, req datasource.Rea
 }
 
 type dataSourceLifecyclePolicyData struct {
+	framework.WithRegionModel
 	CreatedDate      types.String `tfsdk:"created_date"`
 	Description      types.String `tfsdk:"description"`
 	ID               types.String `tfsdk:"id"`
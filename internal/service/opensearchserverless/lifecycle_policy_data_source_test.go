@@ -22,7 +22,7 @@ func TestAccOpenSearchServerlessLifecyclePolicyDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_opensearchserverless_lifecycle_policy.test"
 	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
@@ -263,6 +263,7 @@ func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.I
 }
 
 type resourceSecurityConfigData struct {
+	framework.WithRegionModel
 	ID            types.String `tfsdk:"id"`
 	ConfigVersion types.String `tfsdk:"config_version"`
 	Description   types.String `tfsdk:"description"`
@@ -114,6 +114,7 @@ func (d *dataSourceSecurityConfig) Read(ctx context.Context, req datasource.Read
 }
 
 type dataSourceSecurityConfigData struct {
+	framework.WithRegionModel
 	ConfigVersion    types.String `tfsdk:"config_version"`
 	CreatedDate      types.String `tfsdk:"created_date"`
 	Description      types.String `tfsdk:"description"`
@@ -22,7 +22,7 @@ func TestAccOpenSearchServerlessSecurityConfigDataSource_basic(t *testing.T) {
 	resourceName := "aws_opensearchserverless_security_config.test"
 	dataSourceName := "data.aws_opensearchserverless_security_config.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
@@ -36,6 +36,7 @@ func newResourceSecurityPolicy(_ context.Context) (resource.ResourceWithConfigur
 }
 
 type resourceSecurityPolicyData struct {
+	framework.WithRegionModel
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
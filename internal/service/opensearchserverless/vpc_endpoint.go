@@ -45,6 +45,7 @@ func newResourceVPCEndpoint(_ context.Context) (resource.ResourceWithConfigure,
 }
 
 type resourceVpcEndpointData struct {
+	framework.WithRegionModel
 	ID               types.String   `tfsdk:"id"`
 	Name             types.String   `tfsdk:"name"`
 	SecurityGroupIds types.Set      `tfsdk:"security_group_ids"`
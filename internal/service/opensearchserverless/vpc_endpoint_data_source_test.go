@@ -23,7 +23,7 @@ func TestAccOpenSearchServerlessVPCEndpointDataSource_basic(t *testing.T) {
 	resourceName := "aws_opensearchserverless_vpc_endpoint.test"
 	dataSourceName := "data.aws_opensearchserverless_vpc_endpoint.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
@@ -27,7 +27,7 @@ func TestAccOpsWorksStack_basic(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -82,7 +82,7 @@ func TestAccOpsWorksStack_disappears(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -110,7 +110,7 @@ func TestAccOpsWorksStack_noVPC_basic(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -152,7 +152,7 @@ func TestAccOpsWorksStack_noVPC_defaultAZ(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -186,7 +186,7 @@ func TestAccOpsWorksStack_tags(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -236,7 +236,7 @@ func TestAccOpsWorksStack_tagsAlternateRegion(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -307,7 +307,7 @@ func TestAccOpsWorksStack_allAttributes(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -443,7 +443,7 @@ func TestAccOpsWorksStack_windows(t *testing.T) {
 	resourceName := "aws_opsworks_stack.test"
 	var v opsworks.Stack
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, opsworks.EndpointsID)
@@ -23,7 +23,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
 		Name: "aws_opsworks_stack",
 		F:    sweepStacks,
 		Dependencies: []string{
@@ -34,18 +34,18 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
 		Name: "aws_opsworks_application",
 		F:    sweepApplication,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
 		Name: "aws_opsworks_instance",
 		F:    sweepInstance,
 	})
 
 	// This sweep all the custom, ecs, ganglia, etc. layers
-	resource.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
 		Name: "aws_opsworks_layer",
 		F:    sweepLayers,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
 		Name: "aws_opsworks_rds_db_instance",
 		F:    sweepRDSDBInstance,
 		Dependencies: []string{
@@ -62,14 +62,14 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
 		Name: "aws_opsworks_user_profile",
 		F:    sweepUserProfiles,
 	})
 }
 
 func sweepApplication(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_application")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -121,7 +121,7 @@ func sweepApplication(region string) error {
 }
 
 func sweepInstance(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_instance")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -174,7 +174,7 @@ func sweepInstance(region string) error {
 }
 
 func sweepRDSDBInstance(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_rds_db_instance")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -227,7 +227,7 @@ func sweepRDSDBInstance(region string) error {
 }
 
 func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_stack")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -270,7 +270,7 @@ func sweepStacks(region string) error {
 }
 
 func sweepLayers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_layer")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -332,7 +332,7 @@ func sweepLayers(region string) error {
 }
 
 func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_opsworks_user_profile")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
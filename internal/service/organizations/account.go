@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_account", name="Account")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_delegated_administrator")
+// @Region(global=true)
 func ResourceDelegatedAdministrator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegatedAdministratorCreate,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_delegated_administrators")
+// @Region(global=true)
 func DataSourceDelegatedAdministrators() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegatedAdministratorsRead,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_delegated_services")
+// @Region(global=true)
 func DataSourceDelegatedServices() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegatedServicesRead,
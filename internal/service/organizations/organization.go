@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_organization")
+// @Region(global=true)
 func ResourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationCreate,
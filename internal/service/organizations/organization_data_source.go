@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organization")
+// @Region(global=true)
 func DataSourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationRead,
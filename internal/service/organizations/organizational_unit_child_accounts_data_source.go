@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit_child_accounts")
+// @Region(global=true)
 func DataSourceOrganizationalUnitChildAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitChildAccountsRead,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit", name="Organizational Unit")
+// @Region(global=true)
 func DataSourceOrganizationalUnit() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitRead,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit_descendant_accounts")
+// @Region(global=true)
 func DataSourceOrganizationalUnitDescendantAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitDescendantAccountsRead,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_units")
+// @Region(global=true)
 func DataSourceOrganizationalUnits() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitsRead,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policies")
+// @Region(global=true)
 func DataSourcePolicies() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePoliciesRead,
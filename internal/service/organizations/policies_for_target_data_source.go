@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policies_for_target")
+// @Region(global=true)
 func DataSourcePoliciesForTarget() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePoliciesForTargetRead,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy", name="Policy")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
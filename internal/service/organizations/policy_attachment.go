@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy_attachment")
+// @Region(global=true)
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyAttachmentCreate,
@@ -14,6 +14,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policy")
+// @Region(global=true)
 func DataSourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_resource_policy", name="Resource Policy")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_resource_tags")
+// @Region(global=true)
 func DataSourceResourceTags() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceResourceTagsRead,
@@ -28,47 +28,80 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  DataSourceDelegatedAdministrators,
 			TypeName: "aws_organizations_delegated_administrators",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceDelegatedServices,
 			TypeName: "aws_organizations_delegated_services",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOrganization,
 			TypeName: "aws_organizations_organization",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOrganizationalUnit,
 			TypeName: "aws_organizations_organizational_unit",
 			Name:     "Organizational Unit",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOrganizationalUnitChildAccounts,
 			TypeName: "aws_organizations_organizational_unit_child_accounts",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOrganizationalUnitDescendantAccounts,
 			TypeName: "aws_organizations_organizational_unit_descendant_accounts",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceOrganizationalUnits,
 			TypeName: "aws_organizations_organizational_units",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePolicies,
 			TypeName: "aws_organizations_policies",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePoliciesForTarget,
 			TypeName: "aws_organizations_policies_for_target",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourcePolicy,
 			TypeName: "aws_organizations_policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  DataSourceResourceTags,
 			TypeName: "aws_organizations_resource_tags",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -79,6 +112,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccount,
 			TypeName: "aws_organizations_account",
 			Name:     "Account",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -86,15 +122,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceDelegatedAdministrator,
 			TypeName: "aws_organizations_delegated_administrator",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceOrganization,
 			TypeName: "aws_organizations_organization",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceOrganizationalUnit,
 			TypeName: "aws_organizations_organizational_unit",
 			Name:     "Organizational Unit",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -103,6 +148,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePolicy,
 			TypeName: "aws_organizations_policy",
 			Name:     "Policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
@@ -110,11 +158,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourcePolicyAttachment,
 			TypeName: "aws_organizations_policy_attachment",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceResourcePolicy,
 			TypeName: "aws_organizations_resource_policy",
 			Name:     "Resource Policy",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
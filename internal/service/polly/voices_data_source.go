@@ -135,6 +135,7 @@ func (d *dataSourceVoices) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 type dataSourceVoicesData struct {
+	framework.WithRegionModel
 	Engine                         fwtypes.StringEnum[awstypes.Engine]         `tfsdk:"engine"`
 	ID                             types.String                                `tfsdk:"id"`
 	IncludeAdditionalLanguageCodes types.Bool                                  `tfsdk:"include_additional_language_codes"`
@@ -16,7 +16,7 @@ func TestAccPricingProductDataSource_ec2(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_pricing_product.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
@@ -38,7 +38,7 @@ func TestAccPricingProductDataSource_redshift(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_pricing_product.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
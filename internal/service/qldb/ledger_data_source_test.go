@@ -19,7 +19,7 @@ func TestAccQLDBLedgerDataSource_basic(t *testing.T) {
 	resourceName := "aws_qldb_ledger.test"
 	datasourceName := "data.aws_qldb_ledger.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccQuickSightDataSetDataSource_basic(t *testing.T) {
 	resourceName := "aws_quicksight_data_set.test"
 	dataSourceName := "data.aws_quicksight_data_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -258,6 +258,7 @@ func createFolderMembershipID(awsAccountID, folderID, memberType, memberID strin
 }
 
 type resourceFolderMembershipData struct {
+	framework.WithRegionModel
 	AWSAccountID types.String `tfsdk:"aws_account_id"`
 	FolderID     types.String `tfsdk:"folder_id"`
 	ID           types.String `tfsdk:"id"`
@@ -20,7 +20,7 @@ func TestAccQuickSightGroupDataSource_basic(t *testing.T) {
 	resourceName := "aws_quicksight_group.test"
 	dataSourceName := "data.aws_quicksight_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -370,6 +370,7 @@ var (
 )
 
 type resourceIAMPolicyAssignmentData struct {
+	framework.WithRegionModel
 	AssignmentID     types.String `tfsdk:"assignment_id"`
 	AssignmentName   types.String `tfsdk:"assignment_name"`
 	AssignmentStatus types.String `tfsdk:"assignment_status"`
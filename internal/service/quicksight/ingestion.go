@@ -249,6 +249,7 @@ func createIngestionID(awsAccountID, dataSetID, ingestionID string) string {
 }
 
 type resourceIngestionData struct {
+	framework.WithRegionModel
 	ARN             types.String `tfsdk:"arn"`
 	AWSAccountID    types.String `tfsdk:"aws_account_id"`
 	DataSetID       types.String `tfsdk:"data_set_id"`
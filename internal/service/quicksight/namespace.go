@@ -299,6 +299,7 @@ func createNamespaceID(awsAccountID, namespace string) string {
 }
 
 type resourceNamespaceData struct {
+	framework.WithRegionModel
 	ARN            types.String   `tfsdk:"arn"`
 	AWSAccountID   types.String   `tfsdk:"aws_account_id"`
 	CapacityRegion types.String   `tfsdk:"capacity_region"`
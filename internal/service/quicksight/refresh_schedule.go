@@ -169,6 +169,7 @@ func (r *resourceRefreshSchedule) Schema(ctx context.Context, req resource.Schem
 }
 
 type resourceRefreshScheduleData struct {
+	framework.WithRegionModel
 	ARN          types.String `tfsdk:"arn"`
 	AWSAccountID types.String `tfsdk:"aws_account_id"`
 	DataSetID    types.String `tfsdk:"data_set_id"`
@@ -277,6 +277,7 @@ func createTemplateAliasID(awsAccountID, templateID, aliasName string) string {
 }
 
 type resourceTemplateAliasData struct {
+	framework.WithRegionModel
 	AliasName             types.String `tfsdk:"alias_name"`
 	ARN                   types.String `tfsdk:"arn"`
 	AWSAccountID          types.String `tfsdk:"aws_account_id"`
@@ -30,7 +30,7 @@ func TestAccQuickSightTemplateAlias_basic(t *testing.T) {
 	resourceName := "aws_quicksight_template_alias.test"
 	resourceTemplateName := "aws_quicksight_template.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
@@ -66,7 +66,7 @@ func TestAccQuickSightTemplateAlias_disappears(t *testing.T) {
 	aliasName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_quicksight_template_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
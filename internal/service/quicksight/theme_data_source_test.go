@@ -21,7 +21,7 @@ func TestAccQuickSightThemeDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_quicksight_theme.test"
 	themeId := "MIDNIGHT"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
@@ -52,7 +52,7 @@ func TestAccQuickSightThemeDataSource_fullConfig(t *testing.T) {
 	dataSourceName := "data.aws_quicksight_theme.test"
 	themeId := "MIDNIGHT"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
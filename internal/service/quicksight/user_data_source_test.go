@@ -20,7 +20,7 @@ func TestAccQuickSightUserDataSource_basic(t *testing.T) {
 	resourceName := "aws_quicksight_user.test"
 	dataSourceName := "data.aws_quicksight_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 		},
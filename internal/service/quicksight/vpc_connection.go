@@ -520,6 +520,7 @@ func createVPCConnectionID(awsAccountID, vpcConnectionID string) string {
 }
 
 type resourceVPCConnectionData struct {
+	framework.WithRegionModel
 	ID                 types.String   `tfsdk:"id"`
 	ARN                types.String   `tfsdk:"arn"`
 	AWSAccountID       types.String   `tfsdk:"aws_account_id"`
@@ -28,7 +28,7 @@ func TestAccRBinRule_basic(t *testing.T) {
 	resourceType := "EBS_SNAPSHOT"
 	resourceName := "aws_rbin_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rbin.ServiceID)
@@ -89,7 +89,7 @@ func TestAccRBinRule_disappears(t *testing.T) {
 	resourceType := "EBS_SNAPSHOT"
 	resourceName := "aws_rbin_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rbin.ServiceID)
@@ -116,7 +116,7 @@ func TestAccRBinRule_tags(t *testing.T) {
 	resourceType := "EBS_SNAPSHOT"
 	resourceName := "aws_rbin_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.RBin)
@@ -165,7 +165,7 @@ func TestAccRBinRule_lock_config(t *testing.T) {
 	resourceType := "EBS_SNAPSHOT"
 	resourceName := "aws_rbin_rule.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rbin.ServiceID)
@@ -29,7 +29,7 @@ import (
 )
 
 // @SDKResource("aws_rds_cluster_parameter_group", name="Cluster Parameter Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="rds:cluster-pg")
 func ResourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterParameterGroupCreate,
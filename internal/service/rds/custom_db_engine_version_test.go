@@ -40,7 +40,7 @@ func TestAccRDSCustomDBEngineVersion_sqlServer(t *testing.T) {
 	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
 	resourceName := "aws_rds_custom_db_engine_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -88,7 +88,7 @@ func TestAccRDSCustomDBEngineVersion_sqlServerUpdate(t *testing.T) {
 	status := "pending-validation"
 	description2 := "inactive"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -142,7 +142,7 @@ func TestAccRDSCustomDBEngineVersion_oracle(t *testing.T) {
 	rName := fmt.Sprintf("%s%s%d", "19.19.ee.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
 	resourceName := "aws_rds_custom_db_engine_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -189,7 +189,7 @@ func TestAccRDSCustomDBEngineVersion_manifestFile(t *testing.T) {
 	filename := "test-fixtures/custom-oracle-manifest.json"
 	resourceName := "aws_rds_custom_db_engine_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -234,7 +234,7 @@ func TestAccRDSCustomDBEngineVersion_tags(t *testing.T) {
 	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
 	resourceName := "aws_rds_custom_db_engine_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -272,7 +272,7 @@ func TestAccRDSCustomDBEngineVersion_disappears(t *testing.T) {
 	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
 	resourceName := "aws_rds_custom_db_engine_version.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
@@ -341,6 +341,7 @@ func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, tim
 }
 
 type resourceExportTaskData struct {
+	framework.WithRegionModel
 	ExportOnly           types.List     `tfsdk:"export_only"`
 	ExportTaskIdentifier types.String   `tfsdk:"export_task_identifier"`
 	FailureCause         types.String   `tfsdk:"failure_cause"`
@@ -582,7 +582,7 @@ func globalClusterUpgradeMajorEngineVersion(ctx context.Context, meta interface{
 
 		useConn := conn // clusters may not all be in the same region
 
-		if clusterRegion != meta.(*conns.AWSClient).Region {
+		if clusterRegion != meta.(*conns.AWSClient).RegionForContext(ctx) {
 			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
 		}
 
@@ -646,7 +646,7 @@ func globalClusterUpgradeMinorEngineVersion(ctx context.Context, meta interface{
 
 		useConn := conn
 
-		if clusterRegion != meta.(*conns.AWSClient).Region {
+		if clusterRegion != meta.(*conns.AWSClient).RegionForContext(ctx) {
 			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
 		}
 
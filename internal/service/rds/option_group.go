@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_db_option_group", name="DB Option Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="rds:og")
 func ResourceOptionGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOptionGroupCreate,
@@ -30,7 +30,7 @@ import (
 )
 
 // @SDKResource("aws_db_parameter_group", name="DB Parameter Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="rds:pg")
 func ResourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceParameterGroupCreate,
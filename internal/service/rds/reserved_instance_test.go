@@ -33,7 +33,7 @@ func TestAccRDSReservedInstance_basic(t *testing.T) {
 	dataSourceName := "data.aws_rds_reserved_instance_offering.test"
 	instanceCount := "1"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             nil,
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_db_snapshot", name="DB Snapshot")
-// @Tags(identifierAttribute="db_snapshot_arn")
+// @Tags(identifierAttribute="db_snapshot_arn", tagPolicyResourceType="rds:snapshot")
 func ResourceSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSnapshotCreate,
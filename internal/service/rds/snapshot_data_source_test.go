@@ -24,7 +24,7 @@ func TestAccRDSSnapshotDataSource_basic(t *testing.T) {
 	ds1Name := "data.aws_db_snapshot.by_id"
 	ds2Name := "data.aws_db_snapshot.by_tags"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_db_subnet_group", name="DB Subnet Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagPolicyResourceType="rds:subgrp")
 func ResourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSubnetGroupCreate,
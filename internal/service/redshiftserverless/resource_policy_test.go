@@ -23,7 +23,7 @@ func TestAccRedshiftServerlessResourcePolicy_basic(t *testing.T) {
 	resourceName := "aws_redshiftserverless_resource_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckAlternateAccount(t)
@@ -53,7 +53,7 @@ func TestAccRedshiftServerlessResourcePolicy_disappears(t *testing.T) {
 	resourceName := "aws_redshiftserverless_resource_policy.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckAlternateAccount(t)
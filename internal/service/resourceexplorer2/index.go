@@ -250,6 +250,7 @@ func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyP
 
 // See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_Index.html.
 type indexResourceModel struct {
+	framework.WithRegionModel
 	ARN      types.String                           `tfsdk:"arn"`
 	ID       types.String                           `tfsdk:"id"`
 	Tags     types.Map                              `tfsdk:"tags"`
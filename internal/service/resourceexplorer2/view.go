@@ -322,6 +322,7 @@ func (r *resourceView) ModifyPlan(ctx context.Context, request resource.ModifyPl
 
 // See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_View.html.
 type viewResourceModel struct {
+	framework.WithRegionModel
 	DefaultView        types.Bool                                             `tfsdk:"default_view"`
 	Filters            fwtypes.ListNestedObjectValueOf[searchFilterModel]     `tfsdk:"filters"`
 	ID                 types.String                                           `tfsdk:"id"`
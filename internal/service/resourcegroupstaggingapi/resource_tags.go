@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// The Resource Groups Tagging API is eventually consistent.
+	resourceTagsPropagationTimeout = 2 * time.Minute
+)
+
+// @SDKResource("aws_resource_tags", name="Resource Tags")
+func resourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceTagsCreate,
+		ReadWithoutTimeout:   resourceResourceTagsRead,
+		UpdateWithoutTimeout: resourceResourceTagsUpdate,
+		DeleteWithoutTimeout: resourceResourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceTagsImport,
+		},
+
+		CustomizeDiff: resourceResourceTagsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arn := d.Get("resource_arn").(string)
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	if err := tagResource(ctx, conn, arn, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resource Tags (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, resourceTagsPropagationTimeout, func() (interface{}, error) {
+		return findResourceTagMappingByARN(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Tagging API Resource Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	mapping := outputRaw.(*types.ResourceTagMapping)
+
+	// Only the tag keys in configuration are managed by this resource.
+	managedTags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+	tags := KeyValueTags(ctx, mapping.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Only(managedTags)
+
+	d.Set("resource_arn", mapping.ResourceARN)
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	o, n := d.GetChange("tags")
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		if err := untagResource(ctx, conn, d.Id(), removedTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		if err := tagResource(ctx, conn, d.Id(), updatedTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	if len(tags) == 0 {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Resource Tags: %s", d.Id())
+	if err := untagResource(ctx, conn, d.Id(), tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	mapping, err := findResourceTagMappingByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	// All of the resource's tags are managed after import.
+	tags := KeyValueTags(ctx, mapping.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return nil, fmt.Errorf("setting tags: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceResourceTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	if keys := unmanageableTagKeys(tags, meta.(*conns.AWSClient).IgnoreTagsConfig); len(keys) > 0 {
+		return fmt.Errorf("tag keys %q cannot be managed: keys with the \"aws:\" prefix are reserved and keys matching the provider ignore_tags configuration are ignored", keys)
+	}
+
+	return nil
+}
+
+// unmanageableTagKeys returns the sorted keys of any tags that would always be
+// filtered out on Read, leading to a perpetual diff.
+func unmanageableTagKeys(tags tftags.KeyValueTags, ignoreConfig *tftags.IgnoreConfig) []string {
+	managed := tags.IgnoreAWS().IgnoreConfig(ignoreConfig)
+
+	var keys []string
+
+	for _, k := range tags.Keys() {
+		if !managed.KeyExists(k) {
+			keys = append(keys, k)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+func findResourceTagMappingByARN(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string) (*types.ResourceTagMapping, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{arn},
+	}
+
+	var output []types.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func tagResource(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string, tags tftags.KeyValueTags) error {
+	input := &resourcegroupstaggingapi.TagResourcesInput{
+		ResourceARNList: []string{arn},
+		Tags:            tags.IgnoreAWS().Map(),
+	}
+
+	output, err := conn.TagResources(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("tagging resource: %w", err)
+	}
+
+	if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+		return fmt.Errorf("tagging resource: %w", err)
+	}
+
+	return nil
+}
+
+func untagResource(ctx context.Context, conn *resourcegroupstaggingapi.Client, arn string, tags tftags.KeyValueTags) error {
+	input := &resourcegroupstaggingapi.UntagResourcesInput{
+		ResourceARNList: []string{arn},
+		TagKeys:         tags.IgnoreAWS().Keys(),
+	}
+
+	output, err := conn.UntagResources(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("untagging resource: %w", err)
+	}
+
+	if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+		return fmt.Errorf("untagging resource: %w", err)
+	}
+
+	return nil
+}
+
+// failedResourcesError returns an error for any per-resource failures reported
+// by TagResources or UntagResources, which otherwise succeed.
+func failedResourcesError(failed map[string]types.FailureInfo) error {
+	var errs []error
+
+	for arn, v := range failed {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUnmanageableTagKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		tags         map[string]string
+		ignoreConfig *tftags.IgnoreConfig
+		expected     []string
+	}{
+		"no tags": {},
+		"managed tags": {
+			tags: map[string]string{"CostCenter": "1", "Owner": "a"},
+		},
+		"aws prefix": {
+			tags:     map[string]string{"Owner": "a", "aws:cloudformation:stack-name": "b", "aws:foo": "c"},
+			expected: []string{"aws:cloudformation:stack-name", "aws:foo"},
+		},
+		"ignore config": {
+			tags: map[string]string{"CostCenter": "1", "Owner": "a", "kubernetes.io/cluster/test": "owned"},
+			ignoreConfig: &tftags.IgnoreConfig{
+				Keys:        tftags.New(ctx, []string{"Owner"}),
+				KeyPrefixes: tftags.New(ctx, []string{"kubernetes.io/"}),
+			},
+			expected: []string{"Owner", "kubernetes.io/cluster/test"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfresourcegroupstaggingapi.UnmanageableTagKeys(tftags.New(ctx, testCase.tags), testCase.ignoreConfig)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", topicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfresourcegroupstaggingapi.ResourceResourceTags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_unmanagedTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resource_tags.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_unmanagedTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resource_tags" {
+				continue
+			}
+
+			output, err := tfresourcegroupstaggingapi.FindResourceTagMappingByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			tags := tfresourcegroupstaggingapi.KeyValueTags(ctx, output.Tags)
+
+			for k := range rs.Primary.Attributes {
+				if key, ok := strings.CutPrefix(k, "tags."); ok && key != "%" && tags.KeyExists(key) {
+					return fmt.Errorf("Resource Groups Tagging API Resource Tags %s tag (%s) still exists", rs.Primary.ID, key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		_, err := tfresourcegroupstaggingapi.FindResourceTagMappingByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccResourceTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccResourceTagsConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccResourceTagsConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccResourceTagsConfig_unmanagedTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags["key1"], tags_all["key1"]]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
@@ -32,7 +32,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceResourceTags,
+			TypeName: "aws_resource_tags",
+			Name:     "Resource Tags",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource
+// @Region(global=true)
 func newResourceCIDRCollection(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCIDRCollection{}
 
@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource
+// @Region(global=true)
 func newResourceCIDRLocation(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCIDRLocation{}
 
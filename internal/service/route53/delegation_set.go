@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_route53_delegation_set")
+// @Region(global=true)
 func ResourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegationSetCreate,
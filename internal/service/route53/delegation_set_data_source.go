@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_delegation_set")
+// @Region(global=true)
 func DataSourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegationSetRead,
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_route53_health_check", name="Health Check")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="healthcheck")
 func ResourceHealthCheck() *schema.Resource {
 	return &schema.Resource{
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_route53_hosted_zone_dnssec")
+// @Region(global=true)
 func ResourceHostedZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedZoneDNSSECCreate,
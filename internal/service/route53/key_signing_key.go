@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_route53_key_signing_key")
+// @Region(global=true)
 func ResourceKeySigningKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeySigningKeyCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_route53_query_log")
+// @Region(global=true)
 func ResourceQueryLog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueryLogCreate,
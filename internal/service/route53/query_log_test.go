@@ -29,7 +29,7 @@ func TestAccRoute53QueryLog_basic(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	var v route53.QueryLoggingConfig
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			// AWS Commercial: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/query-logs.html
@@ -66,7 +66,7 @@ func TestAccRoute53QueryLog_disappears(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	var v route53.QueryLoggingConfig
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -92,7 +92,7 @@ func TestAccRoute53QueryLog_Disappears_hostedZone(t *testing.T) {
 	domainName := acctest.RandomDomainName()
 	var v route53.QueryLoggingConfig
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -30,6 +30,7 @@ const (
 )
 
 // @SDKResource("aws_route53_record")
+// @Region(global=true)
 func ResourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
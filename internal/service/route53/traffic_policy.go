@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_route53_traffic_policy")
+// @Region(global=true)
 func ResourceTrafficPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrafficPolicyCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_traffic_policy_document")
+// @Region(global=true)
 func DataSourceTrafficPolicyDocument() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTrafficPolicyDocumentRead,
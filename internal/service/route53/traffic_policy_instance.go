@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_route53_traffic_policy_instance")
+// @Region(global=true)
 func ResourceTrafficPolicyInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrafficPolicyInstanceCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_route53_vpc_association_authorization")
+// @Region(global=true)
 func ResourceVPCAssociationAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCAssociationAuthorizationCreate,
@@ -60,7 +61,7 @@ func resourceVPCAssociationAuthorizationCreate(ctx context.Context, d *schema.Re
 		HostedZoneId: aws.String(d.Get("zone_id").(string)),
 		VPC: &route53.VPC{
 			VPCId:     aws.String(d.Get("vpc_id").(string)),
-			VPCRegion: aws.String(meta.(*conns.AWSClient).Region),
+			VPCRegion: aws.String(meta.(*conns.AWSClient).RegionForContext(ctx)),
 		},
 	}
 
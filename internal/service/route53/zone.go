@@ -39,6 +39,7 @@ const (
 )
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="hostedzone")
 func ResourceZone() *schema.Resource {
 	return &schema.Resource{
@@ -145,7 +146,7 @@ func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Private Route53 Hosted Zones can only be created with their first VPC association,
 	// however we need to associate the remaining after creation.
-	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region)
+	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).RegionForContext(ctx))
 
 	if len(vpcs) > 0 {
 		input.VPC = vpcs[0]
@@ -248,7 +249,7 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta interfac
 func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 
 	if d.HasChange("comment") {
 		input := route53.UpdateHostedZoneCommentInput{
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_route53_zone_association")
+// @Region(global=true)
 func ResourceZoneAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneAssociationCreate,
@@ -62,7 +63,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
 
-	vpcRegion := meta.(*conns.AWSClient).Region
+	vpcRegion := meta.(*conns.AWSClient).RegionForContext(ctx)
 	vpcID := d.Get("vpc_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -121,7 +122,7 @@ func resourceZoneAssociationRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).RegionForContext(ctx)
 	}
 
 	hostedZoneSummary, err := GetZoneAssociation(ctx, conn, zoneID, vpcID, vpcRegion)
@@ -170,7 +171,7 @@ func resourceZoneAssociationDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).RegionForContext(ctx)
 	}
 
 	input := &route53.DisassociateVPCFromHostedZoneInput{
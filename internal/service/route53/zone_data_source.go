@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_zone")
+// @Region(global=true)
 func DataSourceZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceZoneRead,
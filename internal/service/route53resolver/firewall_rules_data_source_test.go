@@ -34,7 +34,7 @@ func TestAccRoute53ResolverFirewallRulesDataSource_basic(t *testing.T) {
 	action := "ALLOW"
 	priority := "100"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
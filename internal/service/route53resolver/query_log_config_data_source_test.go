@@ -19,7 +19,7 @@ func TestAccRoute53ResolverQueryLogConfigDataSource_basic(t *testing.T) {
 	resourceName := "aws_route53_resolver_query_log_config.test"
 	dataSourceName := "data.aws_route53_resolver_query_log_config.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, route53resolver.EndpointsID)
@@ -52,7 +52,7 @@ func TestAccRoute53ResolverQueryLogConfigDataSource_filter(t *testing.T) {
 	resourceName := "aws_route53_resolver_query_log_config.test"
 	dataSourceName := "data.aws_route53_resolver_query_log_config.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, route53resolver.EndpointsID)
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithConfigure
+	framework.WithSDKv2ResourceList
+}
+
+func (l *bucketListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list buckets whose names start with this prefix.",
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data bucketListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().S3Client(ctx)
+	region := l.Meta().RegionForContext(ctx)
+	prefix := data.Prefix.ValueString()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// ListBuckets returns all general purpose buckets owned by the account, regardless of Region,
+		// in a single unpaginated response.
+		// Each bucket's Region has to be looked up separately, so filter by name first and stop
+		// once the requested number of results has been returned to keep the number of lookups down.
+		output, err := conn.ListBuckets(ctx, &s3.ListBucketsInput{})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError(create.ProblemStandardMessage(names.S3, create.ErrActionListing, "Buckets", "", nil), err.Error())
+			yield(result)
+			return
+		}
+
+		var count int64
+		for _, v := range output.Buckets {
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
+			name := aws.ToString(v.Name)
+
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+
+			// Only list buckets in the provider's Region.
+			bucketRegion, err := bucketRegion(ctx, conn, name, l.Meta().S3UsePathStyle())
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddWarning(create.ProblemStandardMessage(names.S3, create.ErrActionReading, "Bucket", name, nil), err.Error())
+				if !yield(result) {
+					return
+				}
+				continue
+			}
+
+			if bucketRegion != region {
+				continue
+			}
+
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set("bucket", name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+
+			if !l.SetResult(ctx, l.Meta(), request.IncludeResource, d, &result) {
+				continue
+			}
+
+			count++
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type bucketListResourceModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+	Region types.String `tfsdk:"region"`
+}
@@ -78,15 +78,16 @@ func (d *directoryBucketsDataSource) Read(ctx context.Context, request datasourc
 	}
 
 	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, tfslices.ApplyToAll(buckets, func(v string) string {
-		return d.RegionalARN("s3express", fmt.Sprintf("bucket/%s", v))
+		return d.RegionalARN(ctx, "s3express", fmt.Sprintf("bucket/%s", v))
 	}))
 	data.Buckets = flex.FlattenFrameworkStringValueList(ctx, buckets)
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type directoryBucketsDataSourceModel struct {
+	framework.WithRegionModel
 	ARNs    types.List   `tfsdk:"arns"`
 	Buckets types.List   `tfsdk:"buckets"`
 	ID      types.String `tfsdk:"id"`
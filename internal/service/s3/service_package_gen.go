@@ -25,6 +25,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "bucket",
+						Required: true,
+					},
+				},
+				IDAttrShadowsAttr: "bucket",
+			},
+		},
+	}
+}
+
+func (p *servicePackage) FrameworkListResources(ctx context.Context) []*types.ServicePackageFrameworkListResource {
+	return []*types.ServicePackageFrameworkListResource{
+		{
+			Factory:  newBucketListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
 		},
 	}
 }
@@ -68,7 +87,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceBucket,
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
-			Tags:     &types.ServicePackageResourceTags{},
+			Tags: &types.ServicePackageResourceTags{
+				PolicyResourceType: "s3:bucket",
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "bucket",
+						Required: true,
+					},
+				},
+				IDAttrShadowsAttr: "bucket",
+			},
 		},
 		{
 			Factory:  ResourceBucketAccelerateConfiguration,
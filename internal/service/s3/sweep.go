@@ -25,12 +25,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -41,7 +41,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
 		Name: "aws_s3_directory_bucket",
 		F:    sweepDirectoryBuckets,
 		Dependencies: []string{
@@ -51,7 +51,7 @@ func RegisterSweepers() {
 }
 
 func sweepObjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_s3_object")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -164,7 +164,7 @@ func (os directoryBucketObjectSweeper) Delete(ctx context.Context, timeout time.
 }
 
 func sweepBuckets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_s3_bucket")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -277,7 +277,7 @@ func bucketRegionFilter(ctx context.Context, conn *s3.Client, region string, s3U
 }
 
 func sweepDirectoryBuckets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_s3_directory_bucket")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -35,6 +35,7 @@ import (
 
 // @FrameworkResource(name="Access Grant")
 // @Tags
+// @IdentityAttribute("access_grant_id")
 func newAccessGrantResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &accessGrantResource{}
 
@@ -43,7 +44,7 @@ func newAccessGrantResource(context.Context) (resource.ResourceWithConfigure, er
 
 type accessGrantResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *accessGrantResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -208,7 +209,13 @@ func (r *accessGrantResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
-	if err := data.InitFromID(); err != nil {
+	if data.ID.IsNull() {
+		// Imported by identity.
+		if data.AccountID.IsNull() {
+			data.AccountID = types.StringValue(r.Meta().AccountID)
+		}
+		data.setID()
+	} else if err := data.InitFromID(); err != nil {
 		response.Diagnostics.AddError("parsing resource ID", err.Error())
 
 		return
@@ -340,6 +347,7 @@ func findAccessGrantByTwoPartKey(ctx context.Context, conn *s3control.Client, ac
 }
 
 type accessGrantResourceModel struct {
+	framework.WithRegionModel
 	AccessGrantARN                    types.String                                                            `tfsdk:"access_grant_arn"`
 	AccessGrantID                     types.String                                                            `tfsdk:"access_grant_id"`
 	AccessGrantsLocationConfiguration fwtypes.ListNestedObjectValueOf[accessGrantsLocationConfigurationModel] `tfsdk:"access_grants_location_configuration"`
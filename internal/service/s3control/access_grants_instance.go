@@ -305,6 +305,7 @@ func findAccessGrantsInstance(ctx context.Context, conn *s3control.Client, accou
 }
 
 type accessGrantsInstanceResourceModel struct {
+	framework.WithRegionModel
 	AccessGrantsInstanceARN      types.String `tfsdk:"access_grants_instance_arn"`
 	AccessGrantsInstanceID       types.String `tfsdk:"access_grants_instance_id"`
 	AccountID                    types.String `tfsdk:"account_id"`
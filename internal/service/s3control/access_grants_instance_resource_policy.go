@@ -224,6 +224,7 @@ func findAccessGrantsInstanceResourcePolicy(ctx context.Context, conn *s3control
 }
 
 type accessGrantsInstanceResourcePolicyResourceModel struct {
+	framework.WithRegionModel
 	AccountID types.String      `tfsdk:"account_id"`
 	ID        types.String      `tfsdk:"id"`
 	Policy    fwtypes.IAMPolicy `tfsdk:"policy"`
@@ -290,6 +290,7 @@ func findAccessGrantsLocationByTwoPartKey(ctx context.Context, conn *s3control.C
 }
 
 type accessGrantsLocationResourceModel struct {
+	framework.WithRegionModel
 	AccessGrantsLocationARN types.String `tfsdk:"access_grants_location_arn"`
 	AccessGrantsLocationID  types.String `tfsdk:"access_grants_location_id"`
 	AccountID               types.String `tfsdk:"account_id"`
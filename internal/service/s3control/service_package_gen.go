@@ -24,6 +24,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newAccessGrantResource,
 			Name:    "Access Grant",
 			Tags:    &types.ServicePackageResourceTags{},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name:     "access_grant_id",
+						Required: true,
+					},
+				},
+			},
 		},
 		{
 			Factory: newAccessGrantsInstanceResource,
@@ -18,7 +18,7 @@ func TestAccSageMakerPrebuiltECRImageDataSource_basic(t *testing.T) {
 
 	dataSourceName := "data.aws_sagemaker_prebuilt_ecr_image.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -41,7 +41,7 @@ func TestAccSageMakerPrebuiltECRImageDataSource_region(t *testing.T) {
 
 	dataSourceName := "data.aws_sagemaker_prebuilt_ecr_image.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -33,7 +33,7 @@ import (
 )
 
 // @SDKResource("aws_secretsmanager_secret", name="Secret")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="secretsmanager:secret")
 func resourceSecret() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSecretCreate,
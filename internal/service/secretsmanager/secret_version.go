@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -47,25 +48,40 @@ func resourceSecretVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"has_secret_string_wo": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"secret_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
 			"secret_binary": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
-				ValidateFunc:  verify.ValidBase64String,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: verify.ValidBase64String,
 			},
 			"secret_string": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"secret_string_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				RequiredWith: []string{"secret_string_wo_version"},
+			},
+			"secret_string_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_string_wo"},
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -78,6 +94,10 @@ func resourceSecretVersion() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			verify.WriteOnlyConflictsWith("secret_binary", "secret_string", "secret_string_wo"),
+		},
 	}
 }
 
@@ -102,6 +122,16 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		input.SecretString = aws.String(v.(string))
 	}
 
+	secretStringWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_string_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if secretStringWO != "" {
+		input.SecretString = aws.String(secretStringWO)
+	}
+
 	if v, ok := d.GetOk("version_stages"); ok && v.(*schema.Set).Len() > 0 {
 		input.VersionStages = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -114,6 +144,7 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 
 	versionID := aws.ToString(output.VersionId)
 	d.SetId(secretVersionCreateResourceID(secretID, versionID))
+	d.Set("has_secret_string_wo", secretStringWO != "")
 
 	_, err = tfresource.RetryWhenNotFound(ctx, PropagationTimeout, func() (interface{}, error) {
 		return findSecretVersionByTwoPartKey(ctx, conn, secretID, versionID)
@@ -150,7 +181,10 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("arn", output.ARN)
 	d.Set("secret_binary", verify.Base64Encode(output.SecretBinary))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// Don't persist the value of a write-only secret string.
+	if !d.Get("has_secret_string_wo").(bool) {
+		d.Set("secret_string", output.SecretString)
+	}
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
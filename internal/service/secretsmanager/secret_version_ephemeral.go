@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_secretsmanager_secret_version", name="Secret Version")
+func newEphemeralSecretVersion(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecretVersion{}, nil
+}
+
+const (
+	ERNameSecretVersion = "Secret Version Ephemeral Resource"
+)
+
+type ephemeralSecretVersion struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSecretVersion) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_secretsmanager_secret_version"
+}
+
+func (e *ephemeralSecretVersion) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"created_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_id": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecretVersion) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretVersionData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	secretID := data.SecretID.ValueString()
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+
+	var version string
+	if !data.VersionID.IsNull() {
+		version = data.VersionID.ValueString()
+		input.VersionId = aws.String(version)
+	} else {
+		version = secretVersionStageCurrent
+		if !data.VersionStage.IsNull() {
+			version = data.VersionStage.ValueString()
+		}
+		input.VersionStage = aws.String(version)
+	}
+
+	id := secretVersionCreateResourceID(secretID, version)
+	output, err := findSecretVersion(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, ERNameSecretVersion, id, err), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.ARN)
+	data.CreatedDate = fwtypes.TimestampValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.SecretBinary = flex.StringValueToFramework(ctx, string(output.SecretBinary))
+	data.SecretString = flex.StringToFramework(ctx, output.SecretString)
+	data.VersionID = flex.StringToFramework(ctx, output.VersionId)
+	data.VersionStage = types.StringValue(version)
+	if input.VersionId != nil {
+		data.VersionStage = types.StringNull()
+	}
+	data.VersionStages = fwtypes.NewListValueOfMust[types.String](ctx, flex.FlattenFrameworkStringValueList(ctx, output.VersionStages).Elements())
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretVersionData struct {
+	ARN           types.String                      `tfsdk:"arn"`
+	CreatedDate   fwtypes.Timestamp                 `tfsdk:"created_date"`
+	SecretBinary  types.String                      `tfsdk:"secret_binary"`
+	SecretID      types.String                      `tfsdk:"secret_id"`
+	SecretString  types.String                      `tfsdk:"secret_string"`
+	VersionID     types.String                      `tfsdk:"version_id"`
+	VersionStage  types.String                      `tfsdk:"version_stage"`
+	VersionStages fwtypes.ListValueOf[types.String] `tfsdk:"version_stages"`
+}
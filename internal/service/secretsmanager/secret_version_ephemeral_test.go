@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretVersionEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerEndpointID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_string"), knownvalue.StringExact("test-string")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stage"), knownvalue.StringExact("AWSCURRENT")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stages"), knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("AWSCURRENT")})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretVersionEphemeral_versionID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerEndpointID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralConfig_versionID(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_string"), knownvalue.StringExact("test-string")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stage"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSecretVersionEphemeralConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+`, rName)
+}
+
+func testAccSecretVersionEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSecretVersionEphemeralConfig_base(rName), `
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+}
+
+provider "echo" {
+  data = ephemeral.aws_secretsmanager_secret_version.test
+}
+
+resource "echo" "test" {}
+`)
+}
+
+func testAccSecretVersionEphemeralConfig_versionID(rName string) string {
+	return acctest.ConfigCompose(testAccSecretVersionEphemeralConfig_base(rName), `
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id  = aws_secretsmanager_secret_version.test.secret_id
+  version_id = aws_secretsmanager_secret_version.test.version_id
+}
+
+provider "echo" {
+  data = ephemeral.aws_secretsmanager_secret_version.test
+}
+
+resource "echo" "test" {}
+`)
+}
@@ -20,7 +20,7 @@ func TestAccSecretsManagerSecretsDataSource_filter(t *testing.T) {
 	resourceName := "aws_secretsmanager_secret.test"
 	dataSourceName := "data.aws_secretsmanager_secrets.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -280,6 +280,7 @@ func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client
 }
 
 type awsLogSourceResourceModel struct {
+	framework.WithRegionModel
 	ID     types.String                                             `tfsdk:"id"`
 	Source fwtypes.ListNestedObjectValueOf[awsLogSourceSourceModel] `tfsdk:"source"`
 }
@@ -19,7 +19,7 @@ func TestAccServiceDiscoveryServiceDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_service_discovery_service.test"
 	resourceName := "aws_service_discovery_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, servicediscovery.EndpointsID)
@@ -51,7 +51,7 @@ func TestAccServiceDiscoveryServiceDataSource_private(t *testing.T) {
 	dataSourceName := "data.aws_service_discovery_service.test"
 	resourceName := "aws_service_discovery_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, servicediscovery.EndpointsID)
@@ -83,7 +83,7 @@ func TestAccServiceDiscoveryServiceDataSource_public(t *testing.T) {
 	dataSourceName := "data.aws_service_discovery_service.test"
 	resourceName := "aws_service_discovery_service.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, servicediscovery.EndpointsID)
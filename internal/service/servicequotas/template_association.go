@@ -143,6 +143,7 @@ func (r *resourceTemplateAssociation) ImportState(ctx context.Context, req resou
 }
 
 type resourceTemplateAssociationData struct {
+	framework.WithRegionModel
 	ID          types.String `tfsdk:"id"`
 	SkipDestroy types.Bool   `tfsdk:"skip_destroy"`
 	Status      types.String `tfsdk:"status"`
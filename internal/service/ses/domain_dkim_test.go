@@ -23,7 +23,7 @@ func TestAccSESDomainDKIM_basic(t *testing.T) {
 	resourceName := "aws_ses_domain_dkim.test"
 	domain := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
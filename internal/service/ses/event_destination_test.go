@@ -28,7 +28,7 @@ func TestAccSESEventDestination_basic(t *testing.T) {
 	snsDestinationResourceName := "aws_ses_event_destination.sns"
 	var v1, v2, v3 ses.EventDestination
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -83,7 +83,7 @@ func TestAccSESEventDestination_disappears(t *testing.T) {
 	snsDestinationResourceName := "aws_ses_event_destination.sns"
 	var v1, v2, v3 ses.EventDestination
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheck(ctx, t)
@@ -19,7 +19,7 @@ func TestAccSESV2ConfigurationSetDataSource_basic(t *testing.T) {
 	resourceName := "aws_sesv2_configuration_set.test"
 	dataSourceName := "data.aws_sesv2_configuration_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2EndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
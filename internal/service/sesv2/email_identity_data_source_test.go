@@ -19,7 +19,7 @@ func TestAccSESV2EmailIdentityDataSource_basic(t *testing.T) {
 	resourceName := "aws_sesv2_email_identity.test"
 	dataSourceName := "data.aws_sesv2_email_identity.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2EndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -22,7 +22,7 @@ func TestAccSESV2EmailIdentityMailFromAttributesDataSource_basic(t *testing.T) {
 	resourceName := "aws_sesv2_email_identity_mail_from_attributes.test"
 	dataSourceName := "data.aws_sesv2_email_identity_mail_from_attributes.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2EndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -20,7 +20,7 @@ func TestAccSFNActivityDataSource_basic(t *testing.T) {
 	dataSource1Name := "data.aws_sfn_activity.by_name"
 	dataSource2Name := "data.aws_sfn_activity.by_arn"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -31,7 +31,7 @@ func TestAccSFNAlias_basic(t *testing.T) {
 	resourceName := "aws_sfn_alias.test"
 	functionArnResourcePart := fmt.Sprintf("stateMachine:%s:%s", stateMachineName, aliasName)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -68,7 +68,7 @@ func TestAccSFNAlias_disappears(t *testing.T) {
 	aliasName := fmt.Sprintf("tf_acc_state_machine_alias_basic_%s", rString)
 	resourceName := "aws_sfn_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_sfn_state_machine", name="State Machine")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="states:stateMachine")
 func ResourceStateMachine() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStateMachineCreate,
@@ -18,7 +18,7 @@ func TestAccSFNStateMachineVersionsDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_sfn_state_machine_versions.test"
 	resourceName := "aws_sfn_state_machine.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource(name="Application Layer Automatic Response")
+// @Region(global=true)
 func newResourceApplicationLayerAutomaticResponse(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceApplicationLayerAutomaticResponse{}
 
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource(name="DRT Access Log Bucket Association")
+// @Region(global=true)
 func newResourceDRTAccessLogBucketAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceDRTAccessLogBucketAssociation{}
 
@@ -29,6 +29,7 @@ import (
 
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource(name="DRT Access Role ARN Association")
+// @Region(global=true)
 func newResourceDRTAccessRoleARNAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceDRTAccessRoleARNAssociation{}
 
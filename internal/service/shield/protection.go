@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_shield_protection", name="Protection")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceProtection() *schema.Resource {
 	return &schema.Resource{
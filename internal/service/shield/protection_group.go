@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_shield_protection_group", name="Protection Group")
+// @Region(global=true)
 // @Tags(identifierAttribute="protection_group_arn")
 func ResourceProtectionGroup() *schema.Resource {
 	return &schema.Resource{
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_shield_protection_health_check_association")
+// @Region(global=true)
 func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: ResourceProtectionHealthCheckAssociationCreate,
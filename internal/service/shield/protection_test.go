@@ -26,7 +26,7 @@ func TestAccShieldProtection_globalAccelerator(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -58,7 +58,7 @@ func TestAccShieldProtection_elasticIPAddress(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -90,7 +90,7 @@ func TestAccShieldProtection_disappears(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -117,7 +117,7 @@ func TestAccShieldProtection_alb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -149,7 +149,7 @@ func TestAccShieldProtection_elb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -181,7 +181,7 @@ func TestAccShieldProtection_cloudFront(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -214,7 +214,7 @@ func TestAccShieldProtection_CloudFront_tags(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
@@ -267,7 +267,7 @@ func TestAccShieldProtection_route53(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
 	rName := sdkacctest.RandString(10)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
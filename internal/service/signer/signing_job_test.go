@@ -26,7 +26,7 @@ func TestAccSignerSigningJob_basic(t *testing.T) {
 	var job signer.DescribeSigningJobOutput
 	var conf signer.GetSigningProfileOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
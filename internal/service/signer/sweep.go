@@ -15,14 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
 		Name: "aws_signer_signing_profile",
 		F:    sweepSigningProfiles,
 	})
 }
 
 func sweepSigningProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_signer_signing_profile")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
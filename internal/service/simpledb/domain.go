@@ -166,6 +166,7 @@ func (r *resourceDomain) ImportState(ctx context.Context, request resource.Impor
 }
 
 type resourceDomainData struct {
+	framework.WithRegionModel
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
 }
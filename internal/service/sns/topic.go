@@ -238,7 +238,7 @@ var (
 )
 
 // @SDKResource("aws_sns_topic", name="Topic")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="sns:topic")
 func resourceTopic() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicCreate,
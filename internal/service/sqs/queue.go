@@ -187,7 +187,7 @@ var (
 )
 
 // @SDKResource("aws_sqs_queue", name="Queue")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", tagPolicyResourceType="sqs:queue")
 func resourceQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueueCreate,
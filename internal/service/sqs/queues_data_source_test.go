@@ -21,7 +21,7 @@ func TestAccSQSQueuesDataSource_queueNamePrefix(t *testing.T) {
 	dataSourceName := "data.aws_sqs_queues.test"
 	resourceName := "aws_sqs_queue.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SQSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
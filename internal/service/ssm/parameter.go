@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,7 +33,7 @@ const (
 )
 
 // @SDKResource("aws_ssm_parameter", name="Parameter")
-// @Tags(identifierAttribute="id", resourceType="Parameter")
+// @Tags(identifierAttribute="id", resourceType="Parameter", tagPolicyResourceType="ssm:parameter")
 func ResourceParameter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceParameterCreate,
@@ -69,11 +71,14 @@ func ResourceParameter() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"has_value_wo": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"insecure_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", "value"},
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 			"key_id": {
 				Type:     schema.TypeString,
@@ -111,11 +116,22 @@ func ResourceParameter() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
 			},
 			"value": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Computed:  true,
+			},
+			"value_wo": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				WriteOnly:    true,
 				Sensitive:    true,
-				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", "value"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 			"version": {
 				Type:     schema.TypeInt,
@@ -130,7 +146,7 @@ func ResourceParameter() *schema.Resource {
 				return old.(string) == ssm.ParameterTierAdvanced && new.(string) == ssm.ParameterTierStandard
 			}),
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("value")
+				return diff.HasChanges("value", "value_wo_version")
 			}),
 			customdiff.ComputedIf("value", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("insecure_value")
@@ -141,6 +157,10 @@ func ResourceParameter() *schema.Resource {
 
 			verify.SetTagsDiff,
 		),
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			verify.WriteOnlyExactlyOneOf("insecure_value", "value", "value_wo"),
+		},
 	}
 }
 
@@ -155,6 +175,16 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 		value = v
 	}
 
+	valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if valueWO != "" {
+		value = valueWO
+	}
+
 	input := &ssm.PutParameterInput{
 		Name:           aws.String(name),
 		Type:           aws.String(d.Get("type").(string)),
@@ -209,6 +239,7 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(name)
+	d.Set("has_value_wo", valueWO != "")
 
 	return append(diags, resourceParameterRead(ctx, d, meta)...)
 }
@@ -259,7 +290,11 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("type", param.Type)
 	d.Set("version", param.Version)
 
-	if _, ok := d.GetOk("insecure_value"); ok && aws.StringValue(param.Type) != ssm.ParameterTypeSecureString {
+	// Don't persist the value of a write-only parameter.
+	if d.Get("has_value_wo").(bool) {
+		d.Set("insecure_value", nil)
+		d.Set("value", nil)
+	} else if _, ok := d.GetOk("insecure_value"); ok && aws.StringValue(param.Type) != ssm.ParameterTypeSecureString {
 		d.Set("insecure_value", param.Value)
 	} else {
 		d.Set("value", param.Value)
@@ -309,6 +344,18 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 			value = v
 		}
+
+		valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if valueWO != "" {
+			value = valueWO
+		}
+		d.Set("has_value_wo", valueWO != "")
+
 		paramInput := &ssm.PutParameterInput{
 			Name:           aws.String(d.Get("name").(string)),
 			Type:           aws.String(d.Get("type").(string)),
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ssm_parameter", name="Parameter")
+func newEphemeralParameter(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParameter{}, nil
+}
+
+const (
+	ERNameParameter = "Parameter Ephemeral Resource"
+)
+
+type ephemeralParameter struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParameter) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *ephemeralParameter) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+				Computed:   true,
+			},
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParameter) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralParameterData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMClient(ctx)
+
+	name := data.Name.ValueString()
+	withDecryption := true
+	if !data.WithDecryption.IsNull() {
+		withDecryption = data.WithDecryption.ValueBool()
+	}
+
+	output, err := findParameterByName(ctx, conn, name, withDecryption)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.SSM, create.ErrActionReading, ERNameParameter, name, err), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.ARN)
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+	data.Value = flex.StringToFramework(ctx, output.Value)
+	data.Version = types.Int64Value(output.Version)
+	data.WithDecryption = types.BoolValue(withDecryption)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralParameterData struct {
+	ARN            types.String                               `tfsdk:"arn"`
+	Name           types.String                               `tfsdk:"name"`
+	Type           fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+	Value          types.String                               `tfsdk:"value"`
+	Version        types.Int64                                `tfsdk:"version"`
+	WithDecryption types.Bool                                 `tfsdk:"with_decryption"`
+}
+
+func findParameterByName(ctx context.Context, conn *ssm.Client, name string, withDecryption bool) (*awstypes.Parameter, error) {
+	input := &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(withDecryption),
+	}
+
+	output, err := conn.GetParameter(ctx, input)
+
+	if errs.IsA[*awstypes.ParameterNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Parameter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Parameter, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMEndpointID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName, "String", "TestValue", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrType), knownvalue.StringExact("String")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("value"), knownvalue.StringExact("TestValue")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("with_decryption"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterEphemeral_secureString(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMEndpointID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName, "SecureString", "TestValue", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrType), knownvalue.StringExact("SecureString")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("value"), knownvalue.StringExact("TestValue")),
+				},
+			},
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName, "SecureString", "TestValue", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("value"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("with_decryption"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func testAccParameterEphemeralConfig_basic(rName, pType, value string, withDecryption bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = %[2]q
+  value = %[3]q
+}
+
+ephemeral "aws_ssm_parameter" "test" {
+  name            = aws_ssm_parameter.test.name
+  with_decryption = %[4]t
+}
+
+provider "echo" {
+  data = ephemeral.aws_ssm_parameter.test
+}
+
+resource "echo" "test" {}
+`, rName, pType, value, withDecryption)
+}
@@ -25,7 +25,7 @@ func TestAccSSMServiceSetting_basic(t *testing.T) {
 	var setting ssm.ServiceSetting
 	resourceName := "aws_ssm_service_setting.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
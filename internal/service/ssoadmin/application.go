@@ -511,6 +511,7 @@ func expandSignInOptions(tfList []signInOptionsData) *awstypes.SignInOptions {
 }
 
 type resourceApplicationData struct {
+	framework.WithRegionModel
 	ApplicationAccount     types.String `tfsdk:"application_account"`
 	ApplicationARN         fwtypes.ARN  `tfsdk:"application_arn"`
 	ApplicationProviderARN fwtypes.ARN  `tfsdk:"application_provider_arn"`
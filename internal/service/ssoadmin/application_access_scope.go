@@ -233,6 +233,7 @@ func findApplicationAccessScopeByID(ctx context.Context, conn *ssoadmin.Client,
 }
 
 type resourceApplicationAccessScopeData struct {
+	framework.WithRegionModel
 	ApplicationARN    fwtypes.ARN  `tfsdk:"application_arn"`
 	AuthorizedTargets types.List   `tfsdk:"authorized_targets"`
 	ID                types.String `tfsdk:"id"`
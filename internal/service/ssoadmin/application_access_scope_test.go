@@ -27,7 +27,7 @@ func TestAccSSOAdminApplicationAccessScope_basic(t *testing.T) {
 	resourceName := "aws_ssoadmin_application_access_scope.test"
 	applicationResourceName := "aws_ssoadmin_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -59,7 +59,7 @@ func TestAccSSOAdminApplicationAccessScope_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssoadmin_application_access_scope.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
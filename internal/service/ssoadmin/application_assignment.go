@@ -210,6 +210,7 @@ func findApplicationAssignmentByID(ctx context.Context, conn *ssoadmin.Client, i
 }
 
 type resourceApplicationAssignmentData struct {
+	framework.WithRegionModel
 	ApplicationARN types.String `tfsdk:"application_arn"`
 	ID             types.String `tfsdk:"id"`
 	PrincipalID    types.String `tfsdk:"principal_id"`
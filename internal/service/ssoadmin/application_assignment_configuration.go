@@ -197,6 +197,7 @@ func findApplicationAssignmentConfigurationByID(ctx context.Context, conn *ssoad
 }
 
 type resourceApplicationAssignmentConfigurationData struct {
+	framework.WithRegionModel
 	ApplicationARN     types.String `tfsdk:"application_arn"`
 	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
 	ID                 types.String `tfsdk:"id"`
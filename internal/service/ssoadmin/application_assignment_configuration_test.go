@@ -27,7 +27,7 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_basic(t *testing.T) {
 	resourceName := "aws_ssoadmin_application_assignment_configuration.test"
 	applicationResourceName := "aws_ssoadmin_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -60,7 +60,7 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_disappears_Application(t
 	resourceName := "aws_ssoadmin_application_assignment_configuration.test"
 	applicationResourceName := "aws_ssoadmin_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -87,7 +87,7 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_update(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssoadmin_application_assignment_configuration.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
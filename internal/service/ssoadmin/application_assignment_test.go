@@ -28,7 +28,7 @@ func TestAccSSOAdminApplicationAssignment_basic(t *testing.T) {
 	applicationResourceName := "aws_ssoadmin_application.test"
 	userResourceName := "aws_identitystore_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -63,7 +63,7 @@ func TestAccSSOAdminApplicationAssignment_group(t *testing.T) {
 	applicationResourceName := "aws_ssoadmin_application.test"
 	groupResourceName := "aws_identitystore_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -96,7 +96,7 @@ func TestAccSSOAdminApplicationAssignment_disappears(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssoadmin_application_assignment.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -124,7 +124,7 @@ func TestAccSSOAdminApplicationAssignment_disappears_Application(t *testing.T) {
 	resourceName := "aws_ssoadmin_application_assignment.test"
 	applicationResourceName := "aws_ssoadmin_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
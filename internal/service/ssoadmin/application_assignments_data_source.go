@@ -103,6 +103,7 @@ func (d *dataSourceApplicationAssignments) Read(ctx context.Context, req datasou
 }
 
 type dataSourceApplicationAssignmentsData struct {
+	framework.WithRegionModel
 	ApplicationARN         types.String                                               `tfsdk:"application_arn"`
 	ApplicationAssignments fwtypes.ListNestedObjectValueOf[applicationAssignmentData] `tfsdk:"application_assignments"`
 	ID                     types.String                                               `tfsdk:"id"`
@@ -125,6 +125,7 @@ func (d *dataSourceApplication) Read(ctx context.Context, req datasource.ReadReq
 }
 
 type dataSourceApplicationData struct {
+	framework.WithRegionModel
 	ApplicationAccount     types.String `tfsdk:"application_account"`
 	ApplicationARN         fwtypes.ARN  `tfsdk:"application_arn"`
 	ApplicationProviderARN fwtypes.ARN  `tfsdk:"application_provider_arn"`
@@ -18,7 +18,7 @@ func TestAccSSOAdminApplicationDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_ssoadmin_application.test"
 	applicationResourceName := "aws_ssoadmin_application.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
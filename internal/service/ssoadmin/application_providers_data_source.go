@@ -81,7 +81,7 @@ func (d *dataSourceApplicationProviders) Read(ctx context.Context, req datasourc
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
 
 	paginator := ssoadmin.NewListApplicationProvidersPaginator(conn, &ssoadmin.ListApplicationProvidersInput{})
 	var apiObjects []awstypes.ApplicationProvider
@@ -109,6 +109,7 @@ func (d *dataSourceApplicationProviders) Read(ctx context.Context, req datasourc
 }
 
 type dataSourceApplicationProvidersData struct {
+	framework.WithRegionModel
 	ApplicationProviders types.List   `tfsdk:"application_providers"`
 	ID                   types.String `tfsdk:"id"`
 }
@@ -113,6 +113,7 @@ func (d *dataSourcePrincipalApplicationAssignments) Read(ctx context.Context, re
 }
 
 type dataSourcePrincipalApplicationAssignmentsData struct {
+	framework.WithRegionModel
 	InstanceARN            fwtypes.ARN                                                `tfsdk:"instance_arn"`
 	ApplicationAssignments fwtypes.ListNestedObjectValueOf[applicationAssignmentData] `tfsdk:"application_assignments"`
 	ID                     types.String                                               `tfsdk:"id"`
@@ -20,7 +20,7 @@ func TestAccSSOAdminPrincipalApplicationAssignmentsDataSource_basic(t *testing.T
 	applicationResourceName := "aws_ssoadmin_application.test"
 	userResourceName := "aws_identitystore_user.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
@@ -20,15 +20,15 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
 		Name: "aws_ssoadmin_account_assignment",
 		F:    sweepAccountAssignments,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_application", &resource.Sweeper{
 		Name: "aws_ssoadmin_application",
 		F:    sweepApplications,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    sweepPermissionSets,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func RegisterSweepers() {
 }
 
 func sweepAccountAssignments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_ssoadmin_account_assignment")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -131,7 +131,7 @@ func sweepAccountAssignments(region string) error {
 }
 
 func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_ssoadmin_application")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -191,7 +191,7 @@ func sweepApplications(region string) error {
 }
 
 func sweepPermissionSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_ssoadmin_permission_set")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
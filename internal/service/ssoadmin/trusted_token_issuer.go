@@ -497,6 +497,7 @@ func TrustedTokenIssuerParseInstanceARN(conn *conns.AWSClient, id string) (strin
 }
 
 type resourceTrustedTokenIssuerData struct {
+	framework.WithRegionModel
 	ARN                             types.String `tfsdk:"arn"`
 	ClientToken                     types.String `tfsdk:"client_token"`
 	ID                              types.String `tfsdk:"id"`
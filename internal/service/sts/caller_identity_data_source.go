@@ -84,6 +84,7 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 }
 
 type dataSourceCallerIdentityData struct {
+	framework.WithRegionModel
 	AccountID types.String `tfsdk:"account_id"`
 	ARN       types.String `tfsdk:"arn"`
 	ID        types.String `tfsdk:"id"`
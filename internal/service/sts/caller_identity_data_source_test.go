@@ -13,7 +13,7 @@ import (
 
 func TestAccSTSCallerIdentityDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.STSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -16,14 +16,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
 		Name: "aws_swf_domain",
 		F:    sweepDomains,
 	})
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_swf_domain")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -25,7 +25,7 @@ func TestAccSyntheticsGroupAssociation_basic(t *testing.T) {
 	resourceName := "aws_synthetics_group_association.test"
 	var groupSummary synthetics.GroupSummary
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, synthetics.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -56,7 +56,7 @@ func TestAccSyntheticsGroupAssociation_disappears(t *testing.T) {
 	resourceName := "aws_synthetics_group_association.test"
 	var groupSummary synthetics.GroupSummary
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, synthetics.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
 
-	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
 		Name: "aws_transfer_workflow",
 		F:    sweepWorkflows,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 }
 
 func sweepServers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_transfer_server")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -76,7 +76,7 @@ func sweepServers(region string) error {
 }
 
 func sweepWorkflows(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_transfer_workflow")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -239,6 +239,7 @@ func (r *resourcePolicyStore) ImportState(ctx context.Context, request resource.
 }
 
 type resourcePolicyStoreData struct {
+	framework.WithRegionModel
 	ARN                types.String                                        `tfsdk:"arn"`
 	Description        types.String                                        `tfsdk:"description"`
 	ID                 types.String                                        `tfsdk:"id"`
@@ -89,6 +89,7 @@ func (d *dataSourcePolicyStore) Read(ctx context.Context, req datasource.ReadReq
 }
 
 type dataSourcePolicyStoreData struct {
+	framework.WithRegionModel
 	ARN                types.String                                                  `tfsdk:"arn"`
 	CreatedDate        fwtypes.Timestamp                                             `tfsdk:"created_date"`
 	Description        types.String                                                  `tfsdk:"description"`
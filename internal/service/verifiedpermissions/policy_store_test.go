@@ -30,7 +30,7 @@ func TestAccVerifiedPermissionsPolicyStore_basic(t *testing.T) {
 	var policystore verifiedpermissions.GetPolicyStoreOutput
 	resourceName := "aws_verifiedpermissions_policy_store.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -67,7 +67,7 @@ func TestAccVerifiedPermissionsPolicyStore_update(t *testing.T) {
 	var policystore verifiedpermissions.GetPolicyStoreOutput
 	resourceName := "aws_verifiedpermissions_policy_store.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -103,7 +103,7 @@ func TestAccVerifiedPermissionsPolicyStore_disappears(t *testing.T) {
 	var policystore verifiedpermissions.GetPolicyStoreOutput
 	resourceName := "aws_verifiedpermissions_policy_store.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
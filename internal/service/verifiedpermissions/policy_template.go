@@ -243,6 +243,7 @@ func (r *resourcePolicyTemplate) Delete(ctx context.Context, request resource.De
 }
 
 type resourcePolicyTemplateData struct {
+	framework.WithRegionModel
 	CreatedDate      fwtypes.Timestamp `tfsdk:"created_date"`
 	Description      types.String      `tfsdk:"description"`
 	ID               types.String      `tfsdk:"id"`
@@ -29,7 +29,7 @@ func TestAccVerifiedPermissionsPolicyTemplate_basic(t *testing.T) {
 	var policytemplate verifiedpermissions.GetPolicyTemplateOutput
 	resourceName := "aws_verifiedpermissions_policy_template.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -64,7 +64,7 @@ func TestAccVerifiedPermissionsPolicyTemplate_update(t *testing.T) {
 	var policytemplate verifiedpermissions.GetPolicyTemplateOutput
 	resourceName := "aws_verifiedpermissions_policy_template.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -102,7 +102,7 @@ func TestAccVerifiedPermissionsPolicyTemplate_disappears(t *testing.T) {
 	var policytemplate verifiedpermissions.GetPolicyTemplateOutput
 	resourceName := "aws_verifiedpermissions_policy_template.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -238,6 +238,7 @@ func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequ
 }
 
 type resourceSchemaData struct {
+	framework.WithRegionModel
 	ID            types.String `tfsdk:"id"`
 	Definition    types.Object `tfsdk:"definition"`
 	Namespaces    types.Set    `tfsdk:"namespaces"`
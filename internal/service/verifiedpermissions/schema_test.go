@@ -29,7 +29,7 @@ func TestAccVerifiedPermissionsSchema_basic(t *testing.T) {
 	var schema verifiedpermissions.GetSchemaOutput
 	resourceName := "aws_verifiedpermissions_schema.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -65,7 +65,7 @@ func TestAccVerifiedPermissionsSchema_update(t *testing.T) {
 	var schema verifiedpermissions.GetSchemaOutput
 	resourceName := "aws_verifiedpermissions_schema.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
@@ -102,7 +102,7 @@ func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
 	var schema verifiedpermissions.GetSchemaOutput
 	resourceName := "aws_verifiedpermissions_schema.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
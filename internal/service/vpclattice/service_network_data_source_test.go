@@ -19,7 +19,7 @@ func TestAccVPCLatticeServiceNetworkDataSource_basic(t *testing.T) {
 	resourceName := "aws_vpclattice_service_network.test"
 	dataSourceName := "data.aws_vpclattice_service_network.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
@@ -51,7 +51,7 @@ func TestAccVPCLatticeServiceNetworkDataSource_shared(t *testing.T) {
 	resourceName := "aws_vpclattice_service_network.test"
 	dataSourceName := "data.aws_vpclattice_service_network.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckAlternateAccount(t)
@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
 		Name: "aws_vpclattice_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
 		Name: "aws_vpclattice_service_network",
 		F:    sweepServiceNetworks,
 		Dependencies: []string{
@@ -29,14 +29,14 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_target_group", &resource.Sweeper{
 		Name: "aws_vpclattice_target_group",
 		F:    sweepTargetGroups,
 	})
 }
 
 func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_vpclattice_service")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -77,7 +77,7 @@ func sweepServices(region string) error {
 }
 
 func sweepServiceNetworks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_vpclattice_service_network")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -118,7 +118,7 @@ func sweepServiceNetworks(region string) error {
 }
 
 func sweepTargetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.ResourceTypeContext(region, "aws_vpclattice_target_group")
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
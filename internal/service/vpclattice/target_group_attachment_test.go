@@ -25,7 +25,7 @@ func TestAccVPCLatticeTargetGroupAttachment_instance(t *testing.T) {
 	resourceName := "aws_vpclattice_target_group_attachment.test"
 	instanceResourceName := "aws_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
@@ -54,7 +54,7 @@ func TestAccVPCLatticeTargetGroupAttachment_ip(t *testing.T) {
 	resourceName := "aws_vpclattice_target_group_attachment.test"
 	instanceResourceName := "aws_instance.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
@@ -83,7 +83,7 @@ func TestAccVPCLatticeTargetGroupAttachment_lambda(t *testing.T) {
 	resourceName := "aws_vpclattice_target_group_attachment.test"
 	lambdaResourceName := "aws_lambda_function.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
@@ -112,7 +112,7 @@ func TestAccVPCLatticeTargetGroupAttachment_alb(t *testing.T) {
 	resourceName := "aws_vpclattice_target_group_attachment.test"
 	albResourceName := "aws_lb.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
@@ -139,7 +139,7 @@ func TestAccVPCLatticeTargetGroupAttachment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_vpclattice_target_group_attachment.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
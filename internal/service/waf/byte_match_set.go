@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_waf_byte_match_set")
+// @Region(global=true)
 func ResourceByteMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceByteMatchSetCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_waf_geo_match_set")
+// @Region(global=true)
 func ResourceGeoMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGeoMatchSetCreate,
@@ -23,6 +23,7 @@ import (
 const ipSetUpdatesLimit = 1000
 
 // @SDKResource("aws_waf_ipset")
+// @Region(global=true)
 func ResourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPSetCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_ipset")
+// @Region(global=true)
 func DataSourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceIPSetRead,
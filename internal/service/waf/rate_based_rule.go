@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_waf_rate_based_rule", name="Rate Based Rule")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceRateBasedRule() *schema.Resource {
 	return &schema.Resource{
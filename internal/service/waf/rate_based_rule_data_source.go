@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_rate_based_rule")
+// @Region(global=true)
 func DataSourceRateBasedRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRateBasedRuleRead,
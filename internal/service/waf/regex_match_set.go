@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_waf_regex_match_set")
+// @Region(global=true)
 func ResourceRegexMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegexMatchSetCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_waf_regex_pattern_set")
+// @Region(global=true)
 func ResourceRegexPatternSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegexPatternSetCreate,
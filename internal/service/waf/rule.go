@@ -31,6 +31,7 @@ const (
 )
 
 // @SDKResource("aws_waf_rule", name="Rule")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_rule")
+// @Region(global=true)
 func DataSourceRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRuleRead,
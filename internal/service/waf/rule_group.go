@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_waf_rule_group", name="Rule Group")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_waf_size_constraint_set")
+// @Region(global=true)
 func ResourceSizeConstraintSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSizeConstraintSetCreate,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_waf_sql_injection_match_set")
+// @Region(global=true)
 func ResourceSQLInjectionMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSQLInjectionMatchSetCreate,
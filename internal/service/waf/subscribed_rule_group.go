@@ -20,6 +20,7 @@ const (
 )
 
 // @SDKDataSource("aws_waf_subscribed_rule_group")
+// @Region(global=true)
 func DataSourceSubscribedRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSubscribedRuleGroupRead,
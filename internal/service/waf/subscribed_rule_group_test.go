@@ -30,7 +30,7 @@ func TestAccWAFSubscribedRuleGroupDataSource_basic(t *testing.T) {
 
 	datasourceName := "data.aws_waf_subscribed_rule_group.rulegroup"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, waf.EndpointsID) },
 		ErrorCheck:               acctest.ErrorCheck(t, waf.EndpointsID),
 		CheckDestroy:             nil,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_waf_web_acl", name="Web ACL")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceWebACL() *schema.Resource {
 	return &schema.Resource{
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_web_acl")
+// @Region(global=true)
 func DataSourceWebACL() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceWebACLRead,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_waf_xss_match_set")
+// @Region(global=true)
 func ResourceXSSMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceXSSMatchSetCreate,
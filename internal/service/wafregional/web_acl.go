@@ -181,7 +181,7 @@ func ResourceWebACL() *schema.Resource {
 func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 
 	wr := NewRetryer(conn, region)
 	out, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
@@ -207,7 +207,7 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		webACLARN = arn.ARN{
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
 			Service:   "waf-regional",
 		}.String()
@@ -277,7 +277,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 		webACLARN = arn.ARN{
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
 			Service:   "waf-regional",
 		}.String()
@@ -319,7 +319,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 
 	if d.HasChanges("default_action", "rule") {
 		o, n := d.GetChange("rule")
@@ -370,7 +370,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 
 	// First, need to delete all rules
 	rules := d.Get("rule").(*schema.Set).List()
@@ -303,6 +303,7 @@ func FindConnectionAliasByID(ctx context.Context, conn *workspaces.Client, id st
 }
 
 type resourceConnectionAliasData struct {
+	framework.WithRegionModel
 	ID               types.String   `tfsdk:"id"`
 	ConnectionString types.String   `tfsdk:"connection_string"`
 	OwnerAccountId   types.String   `tfsdk:"owner_account_id"`
@@ -29,7 +29,7 @@ func TestAccWorkSpacesConnectionAlias_basic(t *testing.T) {
 	rName := acctest.RandomFQDomainName()
 	resourceName := "aws_workspaces_connection_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(workspaces.ServiceID))
@@ -64,7 +64,7 @@ func TestAccWorkSpacesConnectionAlias_disappears(t *testing.T) {
 	rName := acctest.RandomFQDomainName()
 	resourceName := "aws_workspaces_connection_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(workspaces.ServiceID))
@@ -93,7 +93,7 @@ func TestAccWorkSpacesConnectionAlias_tags(t *testing.T) {
 	rName := acctest.RandomFQDomainName()
 	resourceName := "aws_workspaces_connection_alias.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, strings.ToLower(workspaces.ServiceID))
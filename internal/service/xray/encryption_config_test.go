@@ -25,7 +25,7 @@ func TestAccXRayEncryptionConfig_basic(t *testing.T) {
 	resourceName := "aws_xray_encryption_config.test"
 	keyResourceName := "aws_kms_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.XRayEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
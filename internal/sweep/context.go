@@ -9,12 +9,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
-	ctx = tfsdklog.RegisterStdlogSink(ctx)
+	ctx = context.WithValue(ctx, regionKey, region)
+
+	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweeper")
 
 	ctx = logger(ctx, "sweeper", region)
 
 	return ctx
 }
+
+// ResourceTypeContext returns the context for sweeping the specified resource type in the specified Region.
+// Sweepers registered via AddTestSweepers use it so that the resources they sweep are reported by type.
+func ResourceTypeContext(region, resourceType string) context.Context {
+	return logWithResourceType(Context(region), resourceType)
+}
+
+// regionFromContext returns the Region being swept, or "unknown".
+func regionFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(regionKey).(string); ok && v != "" {
+		return v
+	}
+
+	return "unknown"
+}
+
+// resourceTypeFromContext returns the resource type being swept, or "unknown".
+func resourceTypeFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(resourceTypeKey).(string); ok && v != "" {
+		return v
+	}
+
+	return "unknown"
+}
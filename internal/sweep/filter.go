@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Describable is implemented by Sweepables that can describe the resource to be swept.
+// Only Describable resources can be filtered, and they are identified in reports.
+// Unknown values are returned as zero values; Tags returns nil if the resource's tags are unknown.
+type Describable interface {
+	ID() string
+	Name() string
+	Tags() map[string]string
+	CreationTime() time.Time
+}
+
+// Filters select the resources to be swept.
+type Filters struct {
+	// IncludeNamePrefixes, if not empty, sweeps only resources whose name has one of the prefixes.
+	IncludeNamePrefixes []string
+	// ExcludeNamePrefixes does not sweep resources whose name has any of the prefixes.
+	ExcludeNamePrefixes []string
+	// IncludeTags, if not empty, sweeps only resources with any of the tags.
+	// An empty value matches any value.
+	IncludeTags map[string]string
+	// ExcludeTags does not sweep resources with any of the tags.
+	// An empty value matches any value.
+	ExcludeTags map[string]string
+	// MinAge, if not zero, sweeps only resources created at least this long ago.
+	MinAge time.Duration
+	// MaxAge, if not zero, sweeps only resources created at most this long ago.
+	MaxAge time.Duration
+}
+
+// IsEmpty returns whether no filters are set, i.e. all resources are swept.
+func (f *Filters) IsEmpty() bool {
+	return len(f.IncludeNamePrefixes) == 0 && len(f.ExcludeNamePrefixes) == 0 &&
+		len(f.IncludeTags) == 0 && len(f.ExcludeTags) == 0 &&
+		f.MinAge == 0 && f.MaxAge == 0
+}
+
+// skipReason returns why the resource is not to be swept, or an empty string if it is to be swept.
+// Resources whose name, tags or creation time are needed but unknown are not swept.
+func (f *Filters) skipReason(sweepable Sweepable, now time.Time) string {
+	if f.IsEmpty() {
+		return ""
+	}
+
+	v, ok := sweepable.(Describable)
+
+	if !ok {
+		return "resource cannot be filtered"
+	}
+
+	if len(f.IncludeNamePrefixes) > 0 || len(f.ExcludeNamePrefixes) > 0 {
+		// Many sweepers only set the resource's ID, which is often its name.
+		name := v.Name()
+		if name == "" {
+			name = v.ID()
+		}
+
+		if name == "" {
+			return "name unknown"
+		}
+
+		if len(f.IncludeNamePrefixes) > 0 && !hasAnyPrefix(name, f.IncludeNamePrefixes) {
+			return "name does not match include prefixes"
+		}
+
+		if hasAnyPrefix(name, f.ExcludeNamePrefixes) {
+			return "name matches exclude prefixes"
+		}
+	}
+
+	if len(f.IncludeTags) > 0 || len(f.ExcludeTags) > 0 {
+		tags := v.Tags()
+
+		// Sweepers don't usually read resources' tags, so an excluded resource can't be told apart from an untagged one.
+		if tags == nil {
+			return "tags unknown"
+		}
+
+		if len(f.IncludeTags) > 0 && !hasAnyTag(tags, f.IncludeTags) {
+			return "tags do not match include tags"
+		}
+
+		if hasAnyTag(tags, f.ExcludeTags) {
+			return "tags match exclude tags"
+		}
+	}
+
+	if f.MinAge > 0 || f.MaxAge > 0 {
+		creationTime := v.CreationTime()
+
+		if creationTime.IsZero() {
+			return "creation time unknown"
+		}
+
+		age := now.Sub(creationTime)
+
+		if f.MinAge > 0 && age < f.MinAge {
+			return fmt.Sprintf("younger than %s", f.MinAge)
+		}
+
+		if f.MaxAge > 0 && age > f.MaxAge {
+			return fmt.Sprintf("older than %s", f.MaxAge)
+		}
+	}
+
+	return ""
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAnyTag(tags, match map[string]string) bool {
+	for k, v := range match {
+		if value, ok := tags[k]; ok && (v == "" || v == value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// orchestratorConfig configures SweepOrchestrator.
+type orchestratorConfig struct {
+	dryRun     bool
+	filters    Filters
+	report     *Report
+	reportFile string
+}
+
+// orchestratorConfigFromEnv returns SweepOrchestrator configuration from environment variables.
+func orchestratorConfigFromEnv() (*orchestratorConfig, error) {
+	config := &orchestratorConfig{
+		filters: Filters{
+			IncludeNamePrefixes: splitEnv(envvar.SweepIncludeNamePrefixes),
+			ExcludeNamePrefixes: splitEnv(envvar.SweepExcludeNamePrefixes),
+			IncludeTags:         tagsEnv(envvar.SweepIncludeTags),
+			ExcludeTags:         tagsEnv(envvar.SweepExcludeTags),
+		},
+		reportFile: os.Getenv(envvar.SweepReportFile),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		config.dryRun = dryRun
+	}
+
+	for k, v := range map[string]*time.Duration{
+		envvar.SweepMinAge: &config.filters.MinAge,
+		envvar.SweepMaxAge: &config.filters.MaxAge,
+	} {
+		if s := os.Getenv(k); s != "" {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return nil, fmt.Errorf("environment variable %s: %w", k, err)
+			}
+			*v = d
+		}
+	}
+
+	config.report = newReport(config.dryRun)
+
+	return config, nil
+}
+
+func splitEnv(k string) []string {
+	var values []string
+
+	for _, v := range strings.Split(os.Getenv(k), ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func tagsEnv(k string) map[string]string {
+	values := splitEnv(k)
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(values))
+	for _, v := range values {
+		key, value, _ := strings.Cut(v, "=")
+		tags[key] = value
+	}
+
+	return tags
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+)
+
+// testDescribableSweepable is a fake Describable resource.
+type testDescribableSweepable struct {
+	testSweepable
+	creationTime time.Time
+	id           string
+	tags         map[string]string
+}
+
+func (s testDescribableSweepable) ID() string {
+	return s.id
+}
+
+func (s testDescribableSweepable) Name() string {
+	return s.testSweepable.name
+}
+
+func (s testDescribableSweepable) Tags() map[string]string {
+	return s.tags
+}
+
+func (s testDescribableSweepable) CreationTime() time.Time {
+	return s.creationTime
+}
+
+func TestFiltersSkipReason(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	resource := testDescribableSweepable{
+		testSweepable: testSweepable{name: "tf-acc-test-1234"},
+		creationTime:  now.Add(-48 * time.Hour),
+		id:            "vpc-12345678",
+		tags: map[string]string{
+			"Team":  "platform",
+			"Owner": "ci",
+		},
+	}
+
+	testCases := map[string]struct {
+		filters    Filters
+		sweepable  Sweepable
+		expectSkip bool
+	}{
+		"no filters": {
+			sweepable: testSweepable{name: "example"},
+		},
+		"not describable": {
+			filters: Filters{
+				IncludeNamePrefixes: []string{"tf-acc-test"},
+			},
+			sweepable:  testSweepable{name: "tf-acc-test-1234"},
+			expectSkip: true,
+		},
+		"include name prefix match": {
+			filters: Filters{
+				IncludeNamePrefixes: []string{"terraform-", "tf-acc-test"},
+			},
+			sweepable: resource,
+		},
+		"include name prefix no match": {
+			filters: Filters{
+				IncludeNamePrefixes: []string{"terraform-"},
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"include name prefix matches ID": {
+			filters: Filters{
+				IncludeNamePrefixes: []string{"vpc-"},
+			},
+			sweepable: testDescribableSweepable{id: "vpc-12345678"},
+		},
+		"exclude name prefix": {
+			filters: Filters{
+				ExcludeNamePrefixes: []string{"tf-acc-test"},
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"include tag key": {
+			filters: Filters{
+				IncludeTags: map[string]string{"Owner": ""},
+			},
+			sweepable: resource,
+		},
+		"include tag value no match": {
+			filters: Filters{
+				IncludeTags: map[string]string{"Team": "data"},
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"exclude tag value": {
+			filters: Filters{
+				ExcludeTags: map[string]string{"Team": "platform"},
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"exclude tag no match": {
+			filters: Filters{
+				ExcludeTags: map[string]string{"Team": "data"},
+			},
+			sweepable: resource,
+		},
+		"exclude tag unknown": {
+			filters: Filters{
+				ExcludeTags: map[string]string{"Team": "platform"},
+			},
+			sweepable:  testDescribableSweepable{id: "vpc-12345678"},
+			expectSkip: true,
+		},
+		"exclude tag untagged": {
+			filters: Filters{
+				ExcludeTags: map[string]string{"Team": "platform"},
+			},
+			sweepable: testDescribableSweepable{id: "vpc-12345678", tags: map[string]string{}},
+		},
+		"min age": {
+			filters: Filters{
+				MinAge: 24 * time.Hour,
+			},
+			sweepable: resource,
+		},
+		"min age too young": {
+			filters: Filters{
+				MinAge: 72 * time.Hour,
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"max age too old": {
+			filters: Filters{
+				MaxAge: 24 * time.Hour,
+			},
+			sweepable:  resource,
+			expectSkip: true,
+		},
+		"age unknown": {
+			filters: Filters{
+				MinAge: 24 * time.Hour,
+			},
+			sweepable:  testDescribableSweepable{id: "vpc-12345678"},
+			expectSkip: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := testCase.filters.skipReason(testCase.sweepable, now)
+
+			if testCase.expectSkip && reason == "" {
+				t.Error("expected skip, got none")
+			}
+			if !testCase.expectSkip && reason != "" {
+				t.Errorf("unexpected skip: %s", reason)
+			}
+		})
+	}
+}
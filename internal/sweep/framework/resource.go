@@ -118,6 +118,44 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// attribute returns the value of the specified string attribute, or an empty string.
+func (sr *sweepResource) attribute(path string) string {
+	for _, attr := range sr.attributes {
+		if attr.path == path {
+			if v, ok := attr.value.(string); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+func (sr *sweepResource) ID() string {
+	return sr.attribute("id")
+}
+
+func (sr *sweepResource) Name() string {
+	return sr.attribute("name")
+}
+
+// Tags returns the tags set by the sweeper, or nil if it didn't set any.
+func (sr *sweepResource) Tags() map[string]string {
+	for _, attr := range sr.attributes {
+		if attr.path == "tags" {
+			if v, ok := attr.value.(map[string]string); ok {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
+
+func (sr *sweepResource) CreationTime() time.Time {
+	return time.Time{}
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ResourceResult identifies a single resource in a Report.
+type ResourceResult struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ResourceTypeReport reports the resources of a single type in a single Region.
+// In a dry run, Deleted contains the resources that would have been deleted.
+type ResourceTypeReport struct {
+	Deleted []ResourceResult `json:"deleted"`
+	Skipped []ResourceResult `json:"skipped"`
+	Failed  []ResourceResult `json:"failed"`
+}
+
+// Report reports the resources swept, keyed by Region and then by resource type.
+type Report struct {
+	DryRun  bool                                      `json:"dry_run"`
+	Regions map[string]map[string]*ResourceTypeReport `json:"regions"`
+
+	lock sync.Mutex
+}
+
+func newReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:  dryRun,
+		Regions: make(map[string]map[string]*ResourceTypeReport),
+	}
+}
+
+// add adds the results of sweeping resources of a single type in a single Region.
+func (r *Report) add(region, resourceType string, deleted, skipped, failed []ResourceResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	resourceTypes, ok := r.Regions[region]
+	if !ok {
+		resourceTypes = make(map[string]*ResourceTypeReport)
+		r.Regions[region] = resourceTypes
+	}
+
+	report, ok := resourceTypes[resourceType]
+	if !ok {
+		report = &ResourceTypeReport{
+			Deleted: []ResourceResult{},
+			Skipped: []ResourceResult{},
+			Failed:  []ResourceResult{},
+		}
+		resourceTypes[resourceType] = report
+	}
+
+	report.Deleted = append(report.Deleted, deleted...)
+	report.Skipped = append(report.Skipped, skipped...)
+	report.Failed = append(report.Failed, failed...)
+}
+
+// write writes the report as JSON to the specified file, replacing any previous report.
+func (r *Report) write(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that the report is never partially written.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// resourceResult returns the result for a single resource.
+func resourceResult(sweepable Sweepable) ResourceResult {
+	var result ResourceResult
+
+	if v, ok := sweepable.(Describable); ok {
+		result.ID = v.ID()
+		result.Name = v.Name()
+	}
+
+	return result
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSweepOrchestratorReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := logWithResourceType(Context("us-west-2"), "aws_vpc")
+
+	sweepables := func(log *testSweepLog) []Sweepable {
+		return []Sweepable{
+			testDescribableSweepable{testSweepable: testSweepable{log: log, name: "tf-acc-test-1"}, id: "vpc-1"},
+			testDescribableSweepable{testSweepable: testSweepable{log: log, name: "tf-acc-test-2", err: errors.New("DependencyViolation")}, id: "vpc-2"},
+			testDescribableSweepable{testSweepable: testSweepable{log: log, name: "production"}, id: "vpc-3"},
+		}
+	}
+	filters := Filters{
+		IncludeNamePrefixes: []string{"tf-acc-test"},
+	}
+
+	t.Run("sweep", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		reportFile := filepath.Join(t.TempDir(), "report.json")
+		config := &orchestratorConfig{
+			filters:    filters,
+			report:     newReport(false),
+			reportFile: reportFile,
+		}
+
+		if err := sweepOrchestrator(ctx, sweepables(log), config); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if diff := cmp.Diff(log.deleted, []string{"tf-acc-test-1"}); diff != "" {
+			t.Errorf("unexpected deleted diff (+wanted, -got): %s", diff)
+		}
+
+		b, err := os.ReadFile(reportFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Report
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := map[string]map[string]*ResourceTypeReport{
+			"us-west-2": {
+				"aws_vpc": {
+					Deleted: []ResourceResult{{ID: "vpc-1", Name: "tf-acc-test-1"}},
+					Skipped: []ResourceResult{{ID: "vpc-3", Name: "production", Reason: "name does not match include prefixes"}},
+					Failed:  []ResourceResult{{ID: "vpc-2", Name: "tf-acc-test-2", Error: "DependencyViolation"}},
+				},
+			},
+		}
+
+		if got.DryRun {
+			t.Error("report is a dry run")
+		}
+		if diff := cmp.Diff(got.Regions, expected); diff != "" {
+			t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		config := &orchestratorConfig{
+			dryRun:  true,
+			filters: filters,
+			report:  newReport(true),
+		}
+
+		if err := sweepOrchestrator(ctx, sweepables(log), config); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(log.deleted) != 0 {
+			t.Errorf("deleted in dry run: %v", log.deleted)
+		}
+
+		report := config.report.Regions["us-west-2"]["aws_vpc"]
+		if got, expected := len(report.Deleted), 2; got != expected {
+			t.Errorf("would delete: got %d, expected %d", got, expected)
+		}
+		if got, expected := len(report.Skipped), 1; got != expected {
+			t.Errorf("skipped: got %d, expected %d", got, expected)
+		}
+	})
+}
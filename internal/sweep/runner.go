@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// sweeper is a sweeper registered via Register or AddTestSweepers.
+type sweeper struct {
+	name string
+	f    SweeperFn
+	// legacyF is the function of a sweeper registered via AddTestSweepers, which lists and sweeps the resources itself.
+	legacyF      func(region string) error
+	dependencies []string
+}
+
+// sweepers are the sweepers registered via Register or AddTestSweepers, keyed by name.
+var sweepers = make(map[string]*sweeper)
+
+// RunOptions configures RunSweepers.
+type RunOptions struct {
+	// AllowFailures continues running sweepers in later levels after a sweeper fails.
+	AllowFailures bool
+	// DryRun reports the plan without running any sweepers.
+	DryRun bool
+	// Sweepers are the names of the sweepers to run, along with their dependencies.
+	// All registered sweepers are run if empty.
+	Sweepers []string
+}
+
+// RunSweepers runs the sweepers registered via Register or AddTestSweepers in each of the specified Regions.
+// Sweepers are run in dependency order, one level at a time. Sweepers in the same level are run in parallel.
+func RunSweepers(regions []string, opts RunOptions) error {
+	plan, err := planSweepers(sweepers, opts.Sweepers)
+
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, region := range regions {
+		ctx := Context(region)
+
+		if err := runSweeperPlan(ctx, region, plan, SharedRegionalSweepClient, opts); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sweeperPlan is the order in which sweepers are run.
+// Every sweeper in a level depends only on sweepers in earlier levels.
+type sweeperPlan struct {
+	levels [][]*sweeper
+}
+
+// planSweepers returns the plan for running the specified sweepers and their dependencies.
+// All sweepers are planned if names is empty.
+// It is an error for a dependency not to be registered, as its dependents could not be swept.
+func planSweepers(sweepers map[string]*sweeper, names []string) (*sweeperPlan, error) {
+	if len(names) == 0 {
+		for name := range sweepers {
+			names = append(names, name)
+		}
+	}
+
+	g := depgraph.New()
+	plan := &sweeperPlan{}
+	var unregistered []string
+
+	// Add the sweepers, and transitively their dependencies, to the graph.
+	todo := slices.Clone(names)
+	for len(todo) > 0 {
+		name := todo[0]
+		todo = todo[1:]
+
+		if g.HasNode(name) {
+			continue
+		}
+
+		g.AddNode(name)
+
+		s, ok := sweepers[name]
+
+		if !ok {
+			unregistered = append(unregistered, name)
+			continue
+		}
+
+		todo = append(todo, s.dependencies...)
+	}
+
+	for _, name := range names {
+		if _, ok := sweepers[name]; !ok {
+			return nil, fmt.Errorf("sweeper (%s) not found", name)
+		}
+	}
+
+	if len(unregistered) > 0 {
+		slices.Sort(unregistered)
+		return nil, fmt.Errorf("sweeper dependencies not registered: %s", strings.Join(unregistered, ", "))
+	}
+
+	for _, s := range sweepers {
+		if !g.HasNode(s.name) {
+			continue
+		}
+
+		for _, dependency := range s.dependencies {
+			if err := g.AddDependency(s.name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Dependencies are ordered before the sweepers that depend on them.
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	levels := make(map[string]int, len(order))
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		s := sweepers[name]
+
+		level := 0
+		for _, dependency := range dependencies {
+			level = max(level, levels[dependency]+1)
+		}
+		levels[name] = level
+
+		for len(plan.levels) <= level {
+			plan.levels = append(plan.levels, nil)
+		}
+		plan.levels[level] = append(plan.levels[level], s)
+	}
+
+	for _, level := range plan.levels {
+		slices.SortFunc(level, func(a, b *sweeper) int {
+			return cmp.Compare(a.name, b.name)
+		})
+	}
+	return plan, nil
+}
+
+// names returns the names of the sweepers in each level.
+func (p *sweeperPlan) names() [][]string {
+	names := make([][]string, len(p.levels))
+
+	for i, level := range p.levels {
+		for _, s := range level {
+			names[i] = append(names[i], s.name)
+		}
+	}
+
+	return names
+}
+
+type clientFunc func(ctx context.Context, region string) (*conns.AWSClient, error)
+
+// runSweeperPlan runs the planned sweepers in the specified Region.
+func runSweeperPlan(ctx context.Context, region string, plan *sweeperPlan, clientFn clientFunc, opts RunOptions) error {
+	for i, names := range plan.names() {
+		tflog.Info(ctx, "Sweeper plan", map[string]any{
+			"level":    i,
+			"sweepers": names,
+		})
+	}
+
+	if opts.DryRun {
+		tflog.Info(ctx, "Dry run, not sweeping")
+		return nil
+	}
+
+	client, err := clientFn(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	var errs *multierror.Error
+
+	for i, level := range plan.levels {
+		var g multierror.Group
+
+		for _, s := range level {
+			s := s
+
+			g.Go(func() error {
+				if s.legacyF != nil {
+					if err := s.legacyF(region); err != nil {
+						return fmt.Errorf("sweeping %q (%s): %w", s.name, region, err)
+					}
+
+					return nil
+				}
+
+				return sweepResourceType(logWithResourceType(ctx, s.name), client, region, s.name, s.f)
+			})
+		}
+
+		if err := g.Wait().ErrorOrNil(); err != nil {
+			errs = multierror.Append(errs, err)
+
+			if !opts.AllowFailures {
+				tflog.Error(ctx, "Sweeper failed, not running later levels", map[string]any{
+					"level": i,
+				})
+				break
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sweepResourceType lists the resources of a single type and sweeps them.
+func sweepResourceType(ctx context.Context, client *conns.AWSClient, region, name string, f SweeperFn) error {
+	sweepResources, err := f(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing %q (%s): %w", name, region, err)
+	}
+
+	err = SweepOrchestrator(ctx, sweepResources)
+	if err != nil {
+		return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testSweepLog records the order in which fake resources are deleted.
+type testSweepLog struct {
+	deleted []string
+	lock    sync.Mutex
+}
+
+func (l *testSweepLog) append(name string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.deleted = append(l.deleted, name)
+}
+
+type testSweepable struct {
+	err  error
+	log  *testSweepLog
+	name string
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.log.append(s.name)
+
+	return nil
+}
+
+// testSweepers returns sweepers that each sweep a single fake resource of the same name.
+func testSweepers(log *testSweepLog, dependencies map[string][]string, errs map[string]error) map[string]*sweeper {
+	sweepers := make(map[string]*sweeper)
+
+	for name, deps := range dependencies {
+		name := name
+
+		sweepers[name] = &sweeper{
+			name: name,
+			f: func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error) {
+				return []Sweepable{testSweepable{err: errs[name], log: log, name: name}}, nil
+			},
+			dependencies: deps,
+		}
+	}
+
+	return sweepers
+}
+
+func testClient(context.Context, string) (*conns.AWSClient, error) {
+	return new(conns.AWSClient), nil
+}
+
+func TestPlanSweepers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependencies   map[string][]string
+		names          []string
+		expectedLevels [][]string
+		expectError    bool
+	}{
+		"no dependencies": {
+			dependencies: map[string][]string{
+				"aws_b": nil,
+				"aws_a": nil,
+			},
+			expectedLevels: [][]string{{"aws_a", "aws_b"}},
+		},
+		"chain": {
+			dependencies: map[string][]string{
+				"aws_vpc":      {"aws_subnet"},
+				"aws_subnet":   {"aws_instance"},
+				"aws_instance": nil,
+			},
+			expectedLevels: [][]string{{"aws_instance"}, {"aws_subnet"}, {"aws_vpc"}},
+		},
+		"diamond": {
+			dependencies: map[string][]string{
+				"aws_vpc":            {"aws_subnet", "aws_security_group"},
+				"aws_subnet":         {"aws_instance"},
+				"aws_security_group": {"aws_instance"},
+				"aws_instance":       nil,
+				"aws_s3_bucket":      nil,
+			},
+			expectedLevels: [][]string{{"aws_instance", "aws_s3_bucket"}, {"aws_security_group", "aws_subnet"}, {"aws_vpc"}},
+		},
+		"uneven depths": {
+			dependencies: map[string][]string{
+				"aws_vpc":      {"aws_subnet", "aws_instance"},
+				"aws_subnet":   {"aws_instance"},
+				"aws_instance": nil,
+			},
+			expectedLevels: [][]string{{"aws_instance"}, {"aws_subnet"}, {"aws_vpc"}},
+		},
+		"selected with dependencies": {
+			dependencies: map[string][]string{
+				"aws_vpc":       {"aws_subnet"},
+				"aws_subnet":    nil,
+				"aws_s3_bucket": nil,
+			},
+			names:          []string{"aws_vpc"},
+			expectedLevels: [][]string{{"aws_subnet"}, {"aws_vpc"}},
+		},
+		"unregistered dependency": {
+			dependencies: map[string][]string{
+				"aws_vpc": {"aws_transcribe_vocabulary"},
+			},
+			expectError: true,
+		},
+		"not found": {
+			dependencies: map[string][]string{
+				"aws_vpc": nil,
+			},
+			names:       []string{"aws_subnet"},
+			expectError: true,
+		},
+		"cycle": {
+			dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet"},
+				"aws_subnet": {"aws_vpc"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := planSweepers(testSweepers(&testSweepLog{}, testCase.dependencies, nil), testCase.names)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(plan.names(), testCase.expectedLevels); diff != "" {
+				t.Errorf("unexpected levels diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweeperPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dependencies := map[string][]string{
+		"aws_vpc":            {"aws_subnet", "aws_security_group"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_security_group": {"aws_instance"},
+		"aws_instance":       nil,
+	}
+
+	t.Run("ordered", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		plan, err := planSweepers(testSweepers(log, dependencies, nil), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := runSweeperPlan(ctx, "us-west-2", plan, testClient, RunOptions{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := len(log.deleted), 4; got != expected {
+			t.Fatalf("got %d deleted, expected %d: %v", got, expected, log.deleted)
+		}
+		// The sweepers in the middle level run in parallel, in any order.
+		if got, expected := log.deleted[0], "aws_instance"; got != expected {
+			t.Errorf("first deleted: got %s, expected %s", got, expected)
+		}
+		if got, expected := log.deleted[3], "aws_vpc"; got != expected {
+			t.Errorf("last deleted: got %s, expected %s", got, expected)
+		}
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		sweepers := testSweepers(log, dependencies, nil)
+		sweepers["aws_subnet"] = &sweeper{
+			name: "aws_subnet",
+			legacyF: func(region string) error {
+				log.append("aws_subnet")
+				return nil
+			},
+			dependencies: dependencies["aws_subnet"],
+		}
+		plan, err := planSweepers(sweepers, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := runSweeperPlan(ctx, "us-west-2", plan, testClient, RunOptions{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := len(log.deleted), 4; got != expected {
+			t.Fatalf("got %d deleted, expected %d: %v", got, expected, log.deleted)
+		}
+		if got, expected := log.deleted[3], "aws_vpc"; got != expected {
+			t.Errorf("last deleted: got %s, expected %s", got, expected)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		plan, err := planSweepers(testSweepers(log, dependencies, nil), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		noClient := func(context.Context, string) (*conns.AWSClient, error) {
+			return nil, errors.New("client requested in dry run")
+		}
+		if err := runSweeperPlan(ctx, "us-west-2", plan, noClient, RunOptions{DryRun: true}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(log.deleted) != 0 {
+			t.Errorf("deleted in dry run: %v", log.deleted)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		errs := map[string]error{
+			"aws_subnet": errors.New("DependencyViolation"),
+		}
+		plan, err := planSweepers(testSweepers(log, dependencies, errs), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := runSweeperPlan(ctx, "us-west-2", plan, testClient, RunOptions{}); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		// The failed sweeper's level completes, but later levels are not run.
+		if diff := cmp.Diff(log.deleted, []string{"aws_instance", "aws_security_group"}); diff != "" {
+			t.Errorf("unexpected deleted diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("allow failures", func(t *testing.T) {
+		t.Parallel()
+
+		log := &testSweepLog{}
+		errs := map[string]error{
+			"aws_subnet": errors.New("DependencyViolation"),
+		}
+		plan, err := planSweepers(testSweepers(log, dependencies, errs), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := runSweeperPlan(ctx, "us-west-2", plan, testClient, RunOptions{AllowFailures: true}); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if got, expected := log.deleted[len(log.deleted)-1], "aws_vpc"; got != expected {
+			t.Errorf("last deleted: got %s, expected %s", got, expected)
+		}
+	})
+}
@@ -84,6 +84,74 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// creationTimeAttributes are the names of attributes commonly containing a resource's RFC3339 creation time.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+}
+
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+func (sr *sweepResource) Name() string {
+	if _, ok := sr.resource.SchemaMap()["name"]; !ok {
+		return ""
+	}
+
+	v, _ := sr.d.Get("name").(string)
+
+	return v
+}
+
+// Tags returns the tags set by the sweeper, or nil if it didn't set any.
+// Sweepers don't usually read resources' tags, so their absence doesn't mean the resource is untagged.
+func (sr *sweepResource) Tags() map[string]string {
+	if _, ok := sr.resource.SchemaMap()["tags"]; !ok {
+		return nil
+	}
+
+	raw, ok := sr.d.GetOk("tags")
+
+	if !ok {
+		return nil
+	}
+
+	v, ok := raw.(map[string]any)
+
+	if !ok {
+		return nil
+	}
+
+	tags := make(map[string]string, len(v))
+	for k, v := range v {
+		tags[k], _ = v.(string)
+	}
+
+	return tags
+}
+
+func (sr *sweepResource) CreationTime() time.Time {
+	for _, k := range creationTimeAttributes {
+		if _, ok := sr.resource.SchemaMap()[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(string); ok && v != "" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return t
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
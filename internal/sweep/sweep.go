@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -67,24 +69,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -103,22 +109,88 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources in parallel.
+// Resources are filtered, listed instead of deleted in a dry run, and reported
+// as configured by the TF_AWS_SWEEP_* environment variables.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	config, err := sharedOrchestratorConfig()
+
+	if err != nil {
+		return err
+	}
+
+	return sweepOrchestrator(ctx, sweepables, config, optFns...)
+}
+
+// sharedOrchestratorConfig is the SweepOrchestrator configuration, and report, shared by all sweepers.
+var sharedOrchestratorConfig = sync.OnceValues(orchestratorConfigFromEnv)
+
+func sweepOrchestrator(ctx context.Context, sweepables []Sweepable, config *orchestratorConfig, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
 	var g multierror.Group
+	var deleted, skipped, failed []ResourceResult
+	var lock sync.Mutex
+	now := time.Now()
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
+		result := resourceResult(sweepable)
+
+		if reason := config.filters.skipReason(sweepable, now); reason != "" {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"id":     result.ID,
+				"reason": reason,
+			})
+			result.Reason = reason
+			lock.Lock()
+			skipped = append(skipped, result)
+			lock.Unlock()
+			continue
+		}
+
+		if config.dryRun {
+			tflog.Info(ctx, "Would sweep resource", map[string]any{
+				"id":   result.ID,
+				"name": result.Name,
+			})
+			lock.Lock()
+			deleted = append(deleted, result)
+			lock.Unlock()
+			continue
+		}
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			if err != nil {
+				result.Error = err.Error()
+				failed = append(failed, result)
+			} else {
+				deleted = append(deleted, result)
+			}
+
+			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err := g.Wait().ErrorOrNil()
+
+	config.report.add(regionFromContext(ctx), resourceTypeFromContext(ctx), deleted, skipped, failed)
+	if config.reportFile != "" {
+		if err := config.report.write(config.reportFile); err != nil {
+			tflog.Warn(ctx, "Writing sweeper report", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return err
 }
 
 // Deprecated: Usse awsv1.SkipSweepError
@@ -142,9 +214,30 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// AddTestSweepers registers a sweeper that lists and sweeps its resources itself with the testing framework and with RunSweepers.
+// New sweepers should use Register.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = &sweeper{
+		name:         name,
+		legacyF:      s.F,
+		dependencies: s.Dependencies,
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
+// Register registers a sweeper with the testing framework and with RunSweepers.
+// Sweepers named as dependencies are run before this sweeper.
 func Register(name string, f SweeperFn, dependencies ...string) {
+	sweepers[name] = &sweeper{
+		name:         name,
+		f:            f,
+		dependencies: dependencies,
+	}
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -153,24 +246,8 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 			if err != nil {
 				return fmt.Errorf("getting client: %w", err)
 			}
-			sweepResources, err := f(ctx, client)
-
-			if SkipSweepError(err) {
-				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
-					"error": err.Error(),
-				})
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("listing %q (%s): %w", name, region, err)
-			}
-
-			err = SweepOrchestrator(ctx, sweepResources)
-			if err != nil {
-				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
-			}
 
-			return nil
+			return sweepResourceType(ctx, client, region, name, f)
 		},
 	})
 }
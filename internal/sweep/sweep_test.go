@@ -5,12 +5,21 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun  = flag.Bool("sweep-dry-run", false, "Report the sweeper plan without sweeping")
+	flagSweepOrdered = flag.Bool("sweep-ordered", false, "Run sweepers in dependency order, running independent sweepers in parallel")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +27,23 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" && (*flagSweepOrdered || *flagSweepDryRun) {
+		opts := sweep.RunOptions{
+			AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+			DryRun:        *flagSweepDryRun,
+		}
+		if v := flag.Lookup("sweep-run").Value.String(); v != "" {
+			opts.Sweepers = strings.Split(v, ",")
+		}
+
+		if err := sweep.RunSweepers(strings.Split(regions, ","), opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
@@ -33,6 +33,11 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// PropagateToChildResources indicates whether the default tags are also
+	// applied to child resources implicitly created by a resource, e.g. the
+	// EBS volumes attached to an EC2 instance.
+	PropagateToChildResources bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -67,6 +72,41 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// PropagatedTags returns the tags to apply to a child resource implicitly
+// created by a resource: the given tags configured for the child merged
+// over DefaultConfig.Tags, if propagation to child resources is enabled.
+func (dc *DefaultConfig) PropagatedTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || !dc.PropagateToChildResources {
+		return tags
+	}
+
+	return dc.MergeTags(tags)
+}
+
+// RemovePropagatedTags returns the given tags read from a child resource,
+// removing any DefaultConfig.Tags propagated to it that are not also among
+// the configured tags, if propagation to child resources is enabled.
+// Only tag keys and values are compared.
+func (dc *DefaultConfig) RemovePropagatedTags(tags, configuredTags KeyValueTags) KeyValueTags {
+	if dc == nil || !dc.PropagateToChildResources || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := configuredTags[k]; !ok {
+			if defaultVal, ok := dc.Tags[k]; ok && v.ValueString() == defaultVal.ValueString() {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
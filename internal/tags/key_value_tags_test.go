@@ -203,6 +203,191 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigPropagatedTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "propagation disabled",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				PropagateToChildResources: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys some matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "defaultvalue2",
+					"key3": "value3",
+				}),
+				PropagateToChildResources: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.PropagatedTags(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigRemovePropagatedTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		configuredTags KeyValueTags
+		defaultConfig  *DefaultConfig
+		want           map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "propagation disabled",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "propagated tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+				PropagateToChildResources: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "changed value",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+				PropagateToChildResources: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+			},
+		},
+		{
+			name: "configured tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			configuredTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+				PropagateToChildResources: true,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.RemovePropagatedTags(testCase.tags, testCase.configuredTags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	t.Parallel()
 
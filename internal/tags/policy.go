@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// TagPolicy is an AWS Organizations tag policy, used to check resource tags for compliance at plan time.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax-reference.html.
+type TagPolicy struct {
+	rules []tagPolicyRule
+}
+
+type tagPolicyRule struct {
+	key         string   // Compliant capitalization of the tag key.
+	values      []string // Allowed tag values. Empty means any value is allowed.
+	requiredFor []string // Resource types that must have the tag.
+}
+
+// LoadTagPolicy reads a tag policy from the specified JSON file.
+func LoadTagPolicy(path string) (*TagPolicy, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading tag policy (%s): %w", path, err)
+	}
+
+	policy, err := ParseTagPolicy(b)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading tag policy (%s): %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// ParseTagPolicy parses a tag policy document.
+// Both effective policies and policies using the @@assign and @@append value-setting operators are supported.
+func ParseTagPolicy(b []byte) (*TagPolicy, error) {
+	var document struct {
+		Tags map[string]struct {
+			TagKey               json.RawMessage `json:"tag_key"`
+			TagValue             json.RawMessage `json:"tag_value"`
+			ReportRequiredTagFor json.RawMessage `json:"report_required_tag_for"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal(b, &document); err != nil {
+		return nil, err
+	}
+
+	policy := &TagPolicy{}
+
+	for name, v := range document.Tags {
+		keys, err := tagPolicyValues(v.TagKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("tag policy key (%s): tag_key: %w", name, err)
+		}
+
+		rule := tagPolicyRule{
+			key: name,
+		}
+
+		if len(keys) > 0 {
+			rule.key = keys[0]
+		}
+
+		if rule.values, err = tagPolicyValues(v.TagValue); err != nil {
+			return nil, fmt.Errorf("tag policy key (%s): tag_value: %w", name, err)
+		}
+
+		if rule.requiredFor, err = tagPolicyValues(v.ReportRequiredTagFor); err != nil {
+			return nil, fmt.Errorf("tag policy key (%s): report_required_tag_for: %w", name, err)
+		}
+
+		policy.rules = append(policy.rules, rule)
+	}
+
+	slices.SortFunc(policy.rules, func(a, b tagPolicyRule) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	return policy, nil
+}
+
+// tagPolicyValues returns the values of a tag policy element.
+// The element is either a string, a list of strings or an object with value-setting operators.
+func tagPolicyValues(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []string{s}, nil
+	}
+
+	var l []string
+	if err := json.Unmarshal(raw, &l); err == nil {
+		return l, nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &operators); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, operator := range []string{"@@assign", "@@append"} {
+		if v, ok := operators[operator]; ok {
+			v, err := tagPolicyValues(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, v...)
+		}
+	}
+
+	return values, nil
+}
+
+// RequiresTags returns whether the tag policy requires tags on any resource types.
+func (p *TagPolicy) RequiresTags() bool {
+	if p == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(p.rules, func(rule tagPolicyRule) bool {
+		return len(rule.requiredFor) > 0
+	})
+}
+
+// Check returns an error describing each way in which the specified tags do not comply with the tag policy.
+// resourceType is the tagged resource's type in tag policy format, e.g. "ec2:instance".
+func (p *TagPolicy) Check(resourceType string, tags KeyValueTags) error {
+	if p == nil {
+		return nil
+	}
+
+	var errs []error
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, rule := range p.rules {
+		var found bool
+
+		for _, k := range keys {
+			if !strings.EqualFold(k, rule.key) {
+				continue
+			}
+
+			found = true
+
+			if k != rule.key {
+				errs = append(errs, fmt.Errorf("tag key %q does not match the capitalization %q required by the tag policy", k, rule.key))
+			}
+
+			if v := tags.KeyValue(k); v != nil && len(rule.values) > 0 && !slices.ContainsFunc(rule.values, func(pattern string) bool {
+				return tagPolicyValueMatch(pattern, *v)
+			}) {
+				errs = append(errs, fmt.Errorf("tag %q value %q is not allowed by the tag policy, allowed values: %s", k, *v, strings.Join(rule.values, ", ")))
+			}
+		}
+
+		if !found && slices.ContainsFunc(rule.requiredFor, func(v string) bool {
+			return tagPolicyResourceTypeMatch(v, resourceType)
+		}) {
+			errs = append(errs, fmt.Errorf("tag %q is required by the tag policy for %s resources", rule.key, resourceType))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("tags do not comply with the tag policy: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// tagPolicyValueMatch returns whether value matches the tag policy allowed value pattern.
+// A pattern can contain a single `*` wildcard.
+func tagPolicyValueMatch(pattern, value string) bool {
+	prefix, suffix, ok := strings.Cut(pattern, "*")
+
+	if !ok {
+		return pattern == value
+	}
+
+	return len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix)
+}
+
+// tagPolicyResourceTypeMatch returns whether resourceType matches the tag policy resource type pattern.
+// A pattern is either a resource type, e.g. "ec2:instance", or all supported resource types for a service, e.g. "ec2:ALL_SUPPORTED".
+func tagPolicyResourceTypeMatch(pattern, resourceType string) bool {
+	if resourceType == "" {
+		return false
+	}
+
+	if service, ok := strings.CutSuffix(pattern, ":ALL_SUPPORTED"); ok {
+		return strings.HasPrefix(resourceType, service+":")
+	}
+
+	return pattern == resourceType
+}
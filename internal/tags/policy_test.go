@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLoadTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := LoadTagPolicy("testdata/tag_policy.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(policy.rules), 2; got != want {
+		t.Fatalf("rules = %d, want %d", got, want)
+	}
+
+	if _, err := LoadTagPolicy("testdata/no_such_file.json"); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestParseTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		document      string
+		expectedRules []tagPolicyRule
+		expectedErr   bool
+	}{
+		{
+			name:     "effective policy",
+			document: `{"tags": {"owner": {"tag_key": "Owner", "tag_value": ["a", "b"], "report_required_tag_for": ["s3:bucket"]}}}`,
+			expectedRules: []tagPolicyRule{
+				{key: "Owner", values: []string{"a", "b"}, requiredFor: []string{"s3:bucket"}},
+			},
+		},
+		{
+			name:     "operators",
+			document: `{"tags": {"owner": {"tag_key": {"@@assign": "Owner"}, "tag_value": {"@@assign": ["a"], "@@append": ["b"]}}}}`,
+			expectedRules: []tagPolicyRule{
+				{key: "Owner", values: []string{"a", "b"}},
+			},
+		},
+		{
+			name:     "no tag_key",
+			document: `{"tags": {"owner": {}}}`,
+			expectedRules: []tagPolicyRule{
+				{key: "owner"},
+			},
+		},
+		{
+			name:        "invalid JSON",
+			document:    `{"tags": `,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid tag_value",
+			document:    `{"tags": {"owner": {"tag_value": 42}}}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTagPolicy([]byte(testCase.document))
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ParseTagPolicy() err %t, want %t: %s", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := len(got.rules), len(testCase.expectedRules); got != want {
+				t.Fatalf("rules = %d, want %d", got, want)
+			}
+
+			for i, rule := range got.rules {
+				want := testCase.expectedRules[i]
+
+				if rule.key != want.key || strings.Join(rule.values, ",") != strings.Join(want.values, ",") || strings.Join(rule.requiredFor, ",") != strings.Join(want.requiredFor, ",") {
+					t.Errorf("rule %d = %+v, want %+v", i, rule, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTagPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy, err := LoadTagPolicy("testdata/tag_policy.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name          string
+		policy        *TagPolicy
+		resourceType  string
+		tags          KeyValueTags
+		expectedError string
+	}{
+		{
+			name:         "no policy",
+			resourceType: "ec2:instance",
+		},
+		{
+			name:         "compliant",
+			policy:       policy,
+			resourceType: "ec2:instance",
+			tags:         New(ctx, map[string]string{"CostCenter": "100", "Project": "x"}),
+		},
+		{
+			name:         "wildcard value",
+			policy:       policy,
+			resourceType: "ec2:instance",
+			tags:         New(ctx, map[string]string{"CostCenter": "30042"}),
+		},
+		{
+			name:         "not required",
+			policy:       policy,
+			resourceType: "s3:bucket",
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:          "missing required",
+			policy:        policy,
+			resourceType:  "ec2:instance",
+			tags:          New(ctx, map[string]string{"Project": "x"}),
+			expectedError: `tag "CostCenter" is required by the tag policy for ec2:instance resources`,
+		},
+		{
+			name:          "missing required all supported",
+			policy:        policy,
+			resourceType:  "logs:log-group",
+			tags:          New(ctx, map[string]string{}),
+			expectedError: `tag "CostCenter" is required by the tag policy for logs:log-group resources`,
+		},
+		{
+			name:          "disallowed value",
+			policy:        policy,
+			resourceType:  "s3:bucket",
+			tags:          New(ctx, map[string]string{"CostCenter": "400"}),
+			expectedError: `tag "CostCenter" value "400" is not allowed by the tag policy, allowed values: 100, 200, 300*`,
+		},
+		{
+			name:          "capitalization",
+			policy:        policy,
+			resourceType:  "s3:bucket",
+			tags:          New(ctx, map[string]string{"project": "x"}),
+			expectedError: `tag key "project" does not match the capitalization "Project" required by the tag policy`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policy.Check(testCase.resourceType, testCase.tags)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestTagPolicyRequiresTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		document string
+		expected bool
+	}{
+		{
+			name:     "required",
+			document: `{"tags": {"owner": {"tag_key": "Owner", "report_required_tag_for": ["rds:db"]}}}`,
+			expected: true,
+		},
+		{
+			name:     "not required",
+			document: `{"tags": {"owner": {"tag_key": "Owner", "tag_value": ["a", "b"]}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		policy, err := ParseTagPolicy([]byte(testCase.document))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.name, err)
+		}
+
+		if got, want := policy.RequiresTags(), testCase.expected; got != want {
+			t.Errorf("%s: RequiresTags() = %t, want %t", testCase.name, got, want)
+		}
+	}
+
+	var policy *TagPolicy
+	if policy.RequiresTags() {
+		t.Error("nil policy: RequiresTags() = true, want false")
+	}
+}
@@ -4,17 +4,15 @@
 package tfresource
 
 import (
-	"errors"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // NotFound returns true if the error represents a "resource not found" condition.
 // Specifically, NotFound returns true if the error or a wrapped error is of type
 // retry.NotFoundError.
 func NotFound(err error) bool {
-	var e *retry.NotFoundError // nosemgrep:ci.is-not-found-error
-	return errors.As(err, &e)
+	return tfretry.NotFound(err)
 }
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
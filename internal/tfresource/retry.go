@@ -6,15 +6,13 @@ package tfresource
 import (
 	"context"
 	"errors"
-	"fmt"
 	"math/rand"
 	"sync"
 	"time"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -23,109 +21,80 @@ import (
 // If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
 type Retryable func(error) (bool, error)
 
+// retryOptions reproduces the polling of the Plugin SDK's retry.StateChangeConf, which Retry uses:
+// the interval doubles from 200ms, is at least MinTimeout (500ms) and at most 10s, and is not randomized.
+var retryOptions = tfretry.Options{
+	BackoffMinDuration:   100 * time.Millisecond,
+	BackoffMultiplier:    2,
+	BackoffMaxDuration:   10 * time.Second,
+	BackoffFloorDuration: 500 * time.Millisecond,
+	NoJitter:             true,
+}
+
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
+// New code should use retry.Operation, which is typed.
 func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
 	var output interface{}
+	var err, retryableErr error
 
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
+	_, runErr := tfretry.Operation(func(context.Context) (interface{}, error) {
 		output, err = f()
+		return output, err
+	}).
+		If(func(_ interface{}, err error) (bool, error) {
+			again, err := retryable(err)
+			retryableErr = err
+			return again, err
+		}).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout)
+
+	// If the timeout elapsed without a retryable error, the result of the final attempt is returned as is.
+	if errors.Is(runErr, context.DeadlineExceeded) && ctx.Err() == nil && retryableErr == nil {
+		return retryResult(output, err)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return retryResult(output, runErr)
 }
 
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) || tfawserr_sdkv2.ErrCodeEquals(err, codes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		WhenAWSErrCodeEquals(codes...).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
 func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrMessageContains(err, code, message) || tfawserr_sdkv2.ErrMessageContains(err, code, message) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		WhenAWSErrMessageContains(code, message).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		When(errs.IsA[T]).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (interface{}, error), needle string) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsAErrorMessageContains[T](err, needle) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		When(func(err error) bool {
+			return errs.IsAErrorMessageContains[T](err, needle)
+		}).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-
-		output, err = f()
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		if output != t {
-			return retry.RetryableError(fmt.Errorf("output = %v, want %v", output, t))
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
-		output, err = f()
-
-		if err == nil && output != t {
-			err = fmt.Errorf("output = %v, want %v", output, t)
-		}
-	}
+	output, err := tfretry.UntilEqual(func(context.Context) (T, error) {
+		return f()
+	}, t).WithBackoff(retryOptions).Run(ctx, timeout)
 
 	if err != nil {
 		var zero T
@@ -137,13 +106,10 @@ func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t
 
 // RetryWhenHTTPStatusCodeEquals retries the specified function when it returns one of the specified HTTP status codes.
 func RetryWhenHTTPStatusCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), statusCodes ...int) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr_sdkv2.ErrHTTPStatusCodeEquals(err, statusCodes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		WhenHTTPStatusCodeEquals(statusCodes...).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 var ErrFoundResource = errors.New(`found resource`)
@@ -165,24 +131,33 @@ func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (in
 
 // RetryWhenNotFound retries the specified function when it returns a retry.NotFoundError.
 func RetryWhenNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if NotFound(err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		WhenNotFound().
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
 }
 
 // RetryWhenNewResourceNotFound retries the specified function when it returns a retry.NotFoundError and `isNewResource` is true.
 func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if isNewResource && NotFound(err) {
-			return true, err
-		}
+	return retryResult(tfretry.Operation(retryFunc(f)).
+		WhenNewResourceNotFound(isNewResource).
+		WithBackoff(retryOptions).
+		Run(ctx, timeout))
+}
 
-		return false, err
-	})
+// retryFunc adapts an untyped function to retry.Operation.
+func retryFunc(f func() (interface{}, error)) tfretry.OpFunc[interface{}] {
+	return func(context.Context) (interface{}, error) {
+		return f()
+	}
+}
+
+func retryResult(output interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
 }
 
 type Options struct {
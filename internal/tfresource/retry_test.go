@@ -373,6 +373,98 @@ func TestRetryUntilNotFound(t *testing.T) { //nolint:tparallel
 	}
 }
 
+// TestRetryWhen pins the behavior of the Plugin SDK retry.StateChangeConf-based implementation.
+func TestRetryWhen(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	retryableErr := errors.New("retryable")
+
+	testCases := []struct {
+		Name             string
+		Timeout          time.Duration
+		F                func(int32) (interface{}, error)
+		Retryable        tfresource.Retryable
+		ExpectedOutput   interface{}
+		ExpectedErr      error
+		ExpectedAttempts int32
+	}{
+		{
+			Name:    "zero timeout",
+			Timeout: 0,
+			F: func(int32) (interface{}, error) {
+				return 42, nil
+			},
+			Retryable: func(err error) (bool, error) {
+				return false, err
+			},
+			ExpectedOutput:   42,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:    "timeout with retryable error",
+			Timeout: 0,
+			F: func(int32) (interface{}, error) {
+				return nil, retryableErr
+			},
+			Retryable: func(err error) (bool, error) {
+				return true, err
+			},
+			ExpectedErr: retryableErr,
+		},
+		{
+			Name:    "timeout without retryable error",
+			Timeout: 0,
+			F: func(int32) (interface{}, error) {
+				return 42, nil
+			},
+			Retryable: func(err error) (bool, error) {
+				return true, nil
+			},
+			ExpectedOutput: 42,
+		},
+		{
+			Name:    "retryable error after retries",
+			Timeout: 1 * time.Second,
+			F: func(attempt int32) (interface{}, error) {
+				return attempt, nil
+			},
+			Retryable: func(err error) (bool, error) {
+				return true, retryableErr
+			},
+			ExpectedErr: retryableErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+			output, err := tfresource.RetryWhen(ctx, testCase.Timeout, func() (interface{}, error) {
+				return testCase.F(atomic.AddInt32(&attempts, 1))
+			}, testCase.Retryable)
+
+			if testCase.ExpectedErr != nil {
+				if !errors.Is(err, testCase.ExpectedErr) {
+					t.Fatalf("got error %v, expected %v", err, testCase.ExpectedErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output != testCase.ExpectedOutput {
+				t.Errorf("got output %v, expected %v", output, testCase.ExpectedOutput)
+			}
+
+			if testCase.ExpectedAttempts > 0 && attempts != testCase.ExpectedAttempts {
+				t.Errorf("got %d attempts, expected %d", attempts, testCase.ExpectedAttempts)
+			}
+		})
+	}
+}
+
 func TestRetryContext_error(t *testing.T) {
 	ctx := acctest.Context(t)
 	t.Parallel()
@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,6 +17,27 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	PolicyResourceType  string // AWS Organizations tag policy resource type, e.g. "ec2:instance"
+}
+
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Is per-resource Region override supported?
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity always includes the AWS account ID and, for non-global resources, the AWS Region.
+type ServicePackageResourceIdentity struct {
+	Attributes        []IdentityAttribute // Natural key attributes, e.g. "bucket"
+	IsGlobalResource  bool                // Is the resource global (i.e. no "region" identity attribute)?
+	IDAttrShadowsAttr string              // The identity attribute whose value is duplicated in "id", if any
+}
+
+// IdentityAttribute represents a single (string-valued) resource identity attribute.
+type IdentityAttribute struct {
+	Name     string
+	Required bool // Is the attribute required when importing by identity?
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -22,15 +45,35 @@ type ServicePackageResourceTags struct {
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 	Tags    *ServicePackageResourceTags
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Region   *ServicePackageResourceRegion
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+}
+
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	TypeName string
+	Name     string
+}
+
+// ServicePackageFrameworkListResource represents a Terraform Plugin Framework list resource
+// implemented by a service package.
+// The list resource's type name is that of the managed resource whose instances it lists.
+type ServicePackageFrameworkListResource struct {
+	Factory  func(context.Context) (list.ListResourceWithConfigure, error)
+	TypeName string
+	Name     string
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -39,6 +82,7 @@ type ServicePackageSDKDataSource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
 
@@ -48,5 +92,7 @@ type ServicePackageSDKResource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
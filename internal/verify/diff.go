@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -22,12 +23,20 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// If a tag policy is configured at the provider-level, returns an error
+// if the merged tags do not comply with the policy.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
+	if diff.GetRawPlan().GetAttr("tags").IsWhollyKnown() {
+		if err := CheckTagPolicy(ctx, meta.(*conns.AWSClient).TagPolicy, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+			return err
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
@@ -104,6 +113,29 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// CheckTagPolicy returns an error if the specified resource tags do not comply with the tag policy.
+// The resource's type is determined from Context, and tags required for resource types are only checked if it is known.
+func CheckTagPolicy(ctx context.Context, policy *tftags.TagPolicy, tags tftags.KeyValueTags) error {
+	if policy == nil {
+		return nil
+	}
+
+	var resourceType string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		resourceType = inContext.TagPolicyResourceType
+		tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+		if resourceType == "" && policy.RequiresTags() {
+			tflog.Warn(ctx, "Tag policy resource type not known, not checking required tags", map[string]any{
+				"service_package": inContext.ServicePackageName,
+				"resource":        inContext.ResourceName,
+			})
+		}
+	}
+
+	return policy.Check(resourceType, tags)
+}
+
 // SuppressEquivalentStringCaseInsensitive provides custom difference suppression
 // for strings that are equal under case-insensitivity.
 func SuppressEquivalentStringCaseInsensitive(k, old, new string, d *schema.ResourceData) bool {
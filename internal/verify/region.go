@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"strings"
+)
+
+// importIDRegionSeparator separates a resource's import ID from an optional per-resource Region override.
+const importIDRegionSeparator = "@"
+
+// SplitImportIDRegion splits an import ID of the form "<id>@<region>" into its ID and Region parts.
+// If the ID has no valid Region suffix the ID is returned unchanged with an empty Region.
+func SplitImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+	if i < 0 {
+		return id, ""
+	}
+
+	// IDs such as SES email identities legitimately contain the separator.
+	region := id[i+len(importIDRegionSeparator):]
+	if !regionRegexp.MatchString(region) {
+		return id, ""
+	}
+
+	return id[:i], region
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+	}{
+		{
+			id:         "",
+			expectedID: "",
+		},
+		{
+			id:         "vpc-0123456789abcdef0",
+			expectedID: "vpc-0123456789abcdef0",
+		},
+		{
+			id:             "vpc-0123456789abcdef0@us-west-2", //lintignore:AWSAT003
+			expectedID:     "vpc-0123456789abcdef0",
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			id:             "arn:aws:sns:eu-west-1:123456789012:example@us-gov-west-1", //lintignore:AWSAT003,AWSAT005
+			expectedID:     "arn:aws:sns:eu-west-1:123456789012:example",               //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "us-gov-west-1",                                            //lintignore:AWSAT003
+		},
+		{
+			id:         "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			id:         "example@",
+			expectedID: "example@",
+		},
+		{
+			id:             "user@example.com@ap-southeast-2", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "ap-southeast-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.id)
+
+			if gotID != testCase.expectedID {
+				t.Errorf("SplitImportIDRegion(%q) ID = %q, want %q", testCase.id, gotID, testCase.expectedID)
+			}
+			if gotRegion != testCase.expectedRegion {
+				t.Errorf("SplitImportIDRegion(%q) Region = %q, want %q", testCase.id, gotRegion, testCase.expectedRegion)
+			}
+		})
+	}
+}
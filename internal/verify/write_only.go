@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WriteOnlyExactlyOneOf returns a ValidateRawResourceConfigFunc that validates that
+// exactly one of the specified top-level attributes is configured.
+// It is intended for use with an attribute and its write-only counterpart, e.g. "value" and "value_wo",
+// so that the rule is declared once in the resource's ValidateRawResourceConfigFuncs.
+func WriteOnlyExactlyOneOf(attributes ...string) schema.ValidateRawResourceConfigFunc {
+	return validateWriteOnlyOneOf(true, attributes...)
+}
+
+// WriteOnlyConflictsWith returns a ValidateRawResourceConfigFunc that validates that
+// at most one of the specified top-level attributes is configured.
+// It is intended for use with an attribute and its write-only counterpart, e.g. "password" and "password_wo",
+// when neither attribute is required.
+func WriteOnlyConflictsWith(attributes ...string) schema.ValidateRawResourceConfigFunc {
+	return validateWriteOnlyOneOf(false, attributes...)
+}
+
+func validateWriteOnlyOneOf(required bool, attributes ...string) schema.ValidateRawResourceConfigFunc {
+	return func(ctx context.Context, request schema.ValidateResourceConfigFuncRequest, response *schema.ValidateResourceConfigFuncResponse) {
+		config := request.RawConfig
+
+		if !config.IsKnown() || config.IsNull() {
+			return
+		}
+
+		var configured []string
+		for _, attribute := range attributes {
+			if !config.Type().HasAttribute(attribute) {
+				continue
+			}
+
+			v := config.GetAttr(attribute)
+
+			// Defer validation until the value is known.
+			if !v.IsKnown() {
+				return
+			}
+
+			if !v.IsNull() {
+				configured = append(configured, attribute)
+			}
+		}
+
+		list := strings.Join(attributes, ",")
+
+		switch n := len(configured); {
+		case n == 0 && required:
+			response.Diagnostics = append(response.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid combination of arguments",
+				Detail:        fmt.Sprintf("one of `%s` must be specified", list),
+				AttributePath: cty.GetAttrPath(attributes[0]),
+			})
+		case n > 1:
+			response.Diagnostics = append(response.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid combination of arguments",
+				Detail:        fmt.Sprintf("only one of `%s` can be specified, but `%s` were specified", list, strings.Join(configured, ",")),
+				AttributePath: cty.GetAttrPath(configured[1]),
+			})
+		}
+	}
+}
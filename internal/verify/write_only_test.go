@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriteOnlyExactlyOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config    cty.Value
+		required  bool
+		expectErr bool
+	}{
+		"neither configured, required": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.NullVal(cty.String),
+				"value_wo": cty.NullVal(cty.String),
+			}),
+			required:  true,
+			expectErr: true,
+		},
+		"neither configured, not required": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.NullVal(cty.String),
+				"value_wo": cty.NullVal(cty.String),
+			}),
+		},
+		"value configured": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.StringVal("secret"),
+				"value_wo": cty.NullVal(cty.String),
+			}),
+			required: true,
+		},
+		"write-only value configured": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.NullVal(cty.String),
+				"value_wo": cty.StringVal("secret"),
+			}),
+			required: true,
+		},
+		"both configured, required": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.StringVal("secret"),
+				"value_wo": cty.StringVal("secret"),
+			}),
+			required:  true,
+			expectErr: true,
+		},
+		"both configured, not required": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.StringVal("secret"),
+				"value_wo": cty.StringVal("secret"),
+			}),
+			expectErr: true,
+		},
+		"unknown value": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value":    cty.UnknownVal(cty.String),
+				"value_wo": cty.StringVal("secret"),
+			}),
+			required: true,
+		},
+		"attribute not in schema": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"value_wo": cty.StringVal("secret"),
+			}),
+			required: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := WriteOnlyConflictsWith("value", "value_wo")
+			if testCase.required {
+				f = WriteOnlyExactlyOneOf("value", "value_wo")
+			}
+
+			request := schema.ValidateResourceConfigFuncRequest{
+				WriteOnlyAttributesAllowed: true,
+				RawConfig:                  testCase.config,
+			}
+			var response schema.ValidateResourceConfigFuncResponse
+
+			f(context.Background(), request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectErr; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -217,6 +217,7 @@ func ReverseDNS(hostname string) string {
 // described in detail in README.md.
 type ServiceDatum struct {
 	Aliases            []string
+	AwsServiceEnvVar   string
 	Brand              string
 	DeprecatedEnvVar   string
 	EndpointOnly       bool
@@ -225,6 +226,7 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	SDKID              string
 	TfAwsEnvVar        string
 }
 
@@ -261,6 +263,7 @@ func readCSVIntoServiceData() error {
 		p := l.ProviderPackage()
 
 		serviceData[p] = &ServiceDatum{
+			AwsServiceEnvVar:   l.AwsServiceEnvVar(),
 			Brand:              l.Brand(),
 			DeprecatedEnvVar:   l.DeprecatedEnvVar(),
 			EndpointOnly:       l.EndpointOnly(),
@@ -269,6 +272,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l.GoV2Package(),
 			HumanFriendly:      l.HumanFriendly(),
 			ProviderNameUpper:  l.ProviderNameUpper(),
+			SDKID:              l.SdkId(),
 			TfAwsEnvVar:        l.TfAwsEnvVar(),
 		}
 
@@ -386,6 +390,25 @@ func TfAwsEnvVar(service string) string {
 	return ""
 }
 
+// SDKID returns the AWS SDK service ID for the specified service.
+// It is used to look up the service's endpoint in the shared configuration file `services` section.
+func SDKID(service string) string {
+	if v, ok := serviceData[service]; ok {
+		return v.SDKID
+	}
+
+	return ""
+}
+
+// AwsServiceEnvVar returns the AWS SDK `AWS_ENDPOINT_URL_<SERVICE>` environment variable for the specified service.
+func AwsServiceEnvVar(service string) string {
+	if v, ok := serviceData[service]; ok {
+		return v.AwsServiceEnvVar
+	}
+
+	return ""
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
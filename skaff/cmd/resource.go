@@ -16,12 +16,18 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	sdkService    string
+	sdkOps        resource.SDKOperations
+	fromSDK       bool
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if fromSDK {
+			return resource.CreateFromSDK(name, snakeName, sdkService, sdkOps, !clearComments, force, includeTags)
+		}
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +41,11 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().BoolVar(&fromSDK, "from-sdk", false, "generate a complete Plugin Framework resource from the AWS SDK for Go v2 API model")
+	resourceCmd.Flags().StringVar(&sdkService, "sdk-service", "", "with --from-sdk, the service package (default: the current directory's name)")
+	resourceCmd.Flags().StringVar(&sdkOps.Create, "sdk-create", "", "with --from-sdk, the create operation (default: Create<name>)")
+	resourceCmd.Flags().StringVar(&sdkOps.Read, "sdk-read", "", "with --from-sdk, the read operation (default: Get<name> or Describe<name>)")
+	resourceCmd.Flags().StringVar(&sdkOps.Update, "sdk-update", "", "with --from-sdk, the update operation, if any (default: Update<name>)")
+	resourceCmd.Flags().StringVar(&sdkOps.Delete, "sdk-delete", "", "with --from-sdk, the delete operation (default: Delete<name>)")
+	resourceCmd.Flags().StringVar(&sdkOps.List, "sdk-list", "", "with --from-sdk, the list operation used by the sweeper, if any (default: List<name>s)")
 }
@@ -1,15 +1,21 @@
 module github.com/hashicorp/terraform-provider-aws/skaff
 
-go 1.20
+go 1.24.0
 
 require (
 	github.com/YakDriver/regexache v0.23.0
+	github.com/aws/aws-sdk-go-v2/service/ivschat v1.10.6
+	github.com/aws/aws-sdk-go-v2/service/rbin v1.14.5
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.8.0
 )
 
 require (
-	github.com/google/uuid v1.3.1 // indirect
+	github.com/aws/aws-sdk-go-v2 v1.24.1 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.2.10 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.5.10 // indirect
+	github.com/aws/smithy-go v1.19.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
 	golang.org/x/exp v0.0.0-20231006140011-7918f672742d // indirect
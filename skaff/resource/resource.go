@@ -30,6 +30,15 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourcesdk.tmpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.tmpl
+var resourceSDKTestTmpl string
+
+//go:embed sweepsdk.tmpl
+var sweepSDKTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -79,36 +88,187 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 
 	servicePackage := filepath.Base(wd)
 
+	templateData, err := newTemplateData(resName, snakeName, servicePackage, comments, v2, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+	snakeName = templateData.ResourceSnake
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// CreateFromSDK generates a Plugin Framework resource, its acceptance tests, sweeper and documentation
+// from the AWS SDK for Go v2 API model of the resource's lifecycle operations.
+// Operation names that aren't specified default to those of the resource, e.g. CreateRule, GetRule or DescribeRule, UpdateRule, DeleteRule and ListRules.
+func CreateFromSDK(resName, snakeName, servicePackage string, ops SDKOperations, comments, force, tags bool) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	if servicePackage == "" {
+		servicePackage = filepath.Base(wd)
+	}
+
+	templateData, err := newTemplateData(resName, snakeName, servicePackage, comments, true, true, tags)
+	if err != nil {
+		return err
+	}
+	snakeName = templateData.ResourceSnake
+
+	sdkPackage, err := names.AWSGoV2Package(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS SDK for Go v2 package: %w", err)
+	}
+	if sdkPackage == "" {
+		return fmt.Errorf("error checking: %s does not use AWS SDK for Go v2", servicePackage)
+	}
+
+	var reads []string
+	if ops.Read != "" {
+		reads = []string{ops.Read}
+	} else {
+		reads = []string{"Get" + resName, "Describe" + resName}
+	}
+	if ops.Create == "" {
+		ops.Create = "Create" + resName
+	}
+	if ops.Update == "" {
+		ops.Update = "Update" + resName
+	}
+	if ops.Delete == "" {
+		ops.Delete = "Delete" + resName
+	}
+	if ops.List == "" {
+		ops.List = "List" + resName + "s"
+	}
+
+	operations, err := inspectSDKOperations(wd, sdkPackage, append(reads, ops.Create, ops.Update, ops.Delete, ops.List))
+	if err != nil {
+		return err
+	}
+
+	model := &sdkModel{
+		Create: operations[ops.Create],
+		Update: operations[ops.Update],
+		Delete: operations[ops.Delete],
+		List:   operations[ops.List],
+	}
+	for _, read := range reads {
+		if model.Read = operations[read]; model.Read != nil {
+			break
+		}
+	}
+
+	for name, op := range map[string]*SDKOperation{ops.Create: model.Create, strings.Join(reads, " or "): model.Read, ops.Delete: model.Delete} {
+		if op == nil {
+			return fmt.Errorf("error checking: AWS SDK for Go v2 package %s has no operation %s", sdkPackage, name)
+		}
+	}
+
+	data, err := newSDKTemplateData(templateData, sdkPackage, model)
+	if err != nil {
+		return fmt.Errorf("error building resource from AWS SDK for Go v2 API model: %w", err)
+	}
+
+	if data.DefineStatusConst && packageDeclares(wd, "statusNormal") {
+		data.DefineStatusConst = false
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeSDKTemplate("newres", f, resourceSDKTmpl, force, data, nil); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeSDKTemplate("restest", tf, resourceSDKTestTmpl, force, data, map[string]string{
+		"resource": "github.com/hashicorp/terraform-plugin-testing/helper/resource",
+	}); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if data.ListOperation != "" {
+		sweepImports := map[string]string{
+			"framework": "github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+			"resource":  "github.com/hashicorp/terraform-plugin-testing/helper/resource",
+		}
+		if _, err := os.Stat("sweep.go"); errors.Is(err, fs.ErrNotExist) {
+			if err = writeSDKTemplate("sweep", "sweep.go", sweepSDKTmpl, false, data, sweepImports); err != nil {
+				return fmt.Errorf("writing sweeper template: %w", err)
+			}
+			fmt.Println("Wrote sweep.go. Run `make gen` to register the service's sweepers.")
+		} else {
+			src, err := renderSDKTemplate("sweep", sweepSDKTmpl, data, sweepImports)
+			if err != nil {
+				return fmt.Errorf("rendering sweeper template: %w", err)
+			}
+			_, src, _ = bytes.Cut(src, []byte("func RegisterSweepers() {"))
+			fmt.Printf("sweep.go already exists. Add the sweeper to RegisterSweepers and its imports:\n%s", src)
+		}
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	fmt.Printf("Add the following to exports_test.go:\n\tFind%[1]sByID = find%[1]sByID\n\tResource%[1]s = newResource%[1]s\n", resName)
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName, servicePackage string, comments, v2, pluginFramework, tags bool) (TemplateData, error) {
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -123,29 +283,21 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    HumanResName(resName),
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
-	}
-
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
+	}, nil
+}
 
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
+// packageDeclares returns whether any Go file in dir declares the named constant or variable.
+func packageDeclares(dir, name string) bool {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	re := regexache.MustCompile(`(?m)^\s*` + name + `\s*=`)
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
+	for _, file := range files {
+		if b, err := os.ReadFile(file); err == nil && re.Match(b) {
+			return true
+		}
 	}
 
-	return nil
+	return false
 }
 
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
@@ -186,3 +338,25 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 
 	return nil
 }
+
+// writeSDKTemplate renders a template for a resource generated from an AWS SDK for Go v2 API model.
+// Output that can't be formatted is still written so that it can be fixed by hand.
+func writeSDKTemplate(templateName, filename, tmpl string, force bool, d *SDKTemplateData, imports map[string]string) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	src, err := renderSDKTemplate(templateName, tmpl, d, imports)
+	if src == nil {
+		return err
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %s: %s\n", filename, err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+// This file is also compiled into the program that skaff runs to inspect an AWS SDK for Go v2 service package,
+// so it may only import standard library packages and must not reference anything else in this package.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// SDKShapeKind is the kind of an AWS SDK for Go v2 API data type.
+type SDKShapeKind string
+
+const (
+	SDKShapeKindBlob      SDKShapeKind = "blob"
+	SDKShapeKindBoolean   SDKShapeKind = "boolean"
+	SDKShapeKindDocument  SDKShapeKind = "document"
+	SDKShapeKindEnum      SDKShapeKind = "enum"
+	SDKShapeKindFloat     SDKShapeKind = "float"
+	SDKShapeKindInteger   SDKShapeKind = "integer"
+	SDKShapeKindList      SDKShapeKind = "list"
+	SDKShapeKindMap       SDKShapeKind = "map"
+	SDKShapeKindString    SDKShapeKind = "string"
+	SDKShapeKindStructure SDKShapeKind = "structure"
+	SDKShapeKindTimestamp SDKShapeKind = "timestamp"
+	SDKShapeKindUnion     SDKShapeKind = "union"
+)
+
+// SDKShape describes an AWS SDK for Go v2 API data type.
+type SDKShape struct {
+	Kind SDKShapeKind `json:"kind"`
+	// Name is the Go type name of an enum, structure or union, e.g. "RetentionPeriod".
+	Name string `json:"name,omitempty"`
+	// Values are an enum's values, as returned by its Values method.
+	Values []string `json:"values,omitempty"`
+	// Elem is the element shape of a list or map.
+	Elem *SDKShape `json:"elem,omitempty"`
+	// Members are a structure's members, in declaration order.
+	Members []*SDKMember `json:"members,omitempty"`
+	// Recursive is set for a structure nested within itself. Its members are not described again.
+	Recursive bool `json:"recursive,omitempty"`
+}
+
+// SDKMember describes a member of an AWS SDK for Go v2 API structure.
+type SDKMember struct {
+	Name     string    `json:"name"`
+	Required bool      `json:"required,omitempty"`
+	Shape    *SDKShape `json:"shape"`
+}
+
+// SDKOperation describes an AWS SDK for Go v2 API operation's input and output.
+type SDKOperation struct {
+	Name   string    `json:"name"`
+	Input  *SDKShape `json:"input"`
+	Output *SDKShape `json:"output"`
+}
+
+// Member returns the structure member with the specified name, or nil.
+func (s *SDKShape) Member(name string) *SDKMember {
+	if s == nil {
+		return nil
+	}
+
+	for _, m := range s.Members {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
+var (
+	sdkDocumentType = reflect.TypeOf((*interface {
+		MarshalSmithyDocument() ([]byte, error)
+	})(nil)).Elem()
+	sdkTimeType = reflect.TypeOf(time.Time{})
+
+	// sdkNestedContextRegexp matches list indexes and map keys in a parameter validation error's field path.
+	sdkNestedContextRegexp = regexp.MustCompile(`\[[^\]]*\]`)
+)
+
+// DescribeSDKOperation describes the named operation of an AWS SDK for Go v2 service client, or returns nil if the client has no such operation.
+// Required input members are found by calling the operation with incomplete input, which fails the client's parameter validation
+// without sending a request. Nested structures are populated one level deeper on each call so that every level's required members are reported.
+func DescribeSDKOperation(ctx context.Context, client any, name string) (*SDKOperation, error) {
+	method := reflect.ValueOf(client).MethodByName(name)
+	if !method.IsValid() {
+		return nil, nil
+	}
+
+	// func(context.Context, *Input, ...func(*Options)) (*Output, error).
+	t := method.Type()
+	if t.NumIn() != 3 || !t.IsVariadic() || t.NumOut() != 2 || t.In(1).Kind() != reflect.Ptr || t.Out(0).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("%s is not an AWS SDK for Go v2 operation", name)
+	}
+
+	tInput, tOutput := t.In(1).Elem(), t.Out(0).Elem()
+	op := &SDKOperation{
+		Name:   name,
+		Input:  describeSDKShape(tInput, map[reflect.Type]bool{}),
+		Output: describeSDKShape(tOutput, map[reflect.Type]bool{}),
+	}
+
+	optFns := reflect.MakeSlice(t.In(2), 1, 1)
+	optFns.Index(0).Set(reflect.MakeFunc(t.In(2).Elem(), func(args []reflect.Value) []reflect.Value {
+		setSDKOfflineOptions(args[0].Elem())
+		return nil
+	}))
+
+	for depth := 0; ; depth++ {
+		input := reflect.New(tInput)
+		more := populateSDKStruct(input.Elem(), depth, map[reflect.Type]bool{})
+
+		results := method.CallSlice([]reflect.Value{reflect.ValueOf(ctx), input, optFns})
+		if err, ok := results[1].Interface().(error); ok && err != nil {
+			for _, path := range sdkRequiredParameters(err) {
+				op.Input.markRequired(strings.Split(path, "."))
+			}
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return op, nil
+}
+
+func describeSDKShape(t reflect.Type, stack map[reflect.Type]bool) *SDKShape {
+	if t == sdkTimeType {
+		return &SDKShape{Kind: SDKShapeKindTimestamp}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return describeSDKShape(t.Elem(), stack)
+
+	case reflect.Bool:
+		return &SDKShape{Kind: SDKShapeKindBoolean}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &SDKShape{Kind: SDKShapeKindInteger}
+
+	case reflect.Float32, reflect.Float64:
+		return &SDKShape{Kind: SDKShapeKindFloat}
+
+	case reflect.String:
+		if values := sdkEnumValues(t); values != nil {
+			return &SDKShape{Kind: SDKShapeKindEnum, Name: t.Name(), Values: values}
+		}
+		return &SDKShape{Kind: SDKShapeKindString}
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &SDKShape{Kind: SDKShapeKindBlob}
+		}
+		return &SDKShape{Kind: SDKShapeKindList, Elem: describeSDKShape(t.Elem(), stack)}
+
+	case reflect.Map:
+		return &SDKShape{Kind: SDKShapeKindMap, Elem: describeSDKShape(t.Elem(), stack)}
+
+	case reflect.Interface:
+		if t.Implements(sdkDocumentType) {
+			return &SDKShape{Kind: SDKShapeKindDocument}
+		}
+		return &SDKShape{Kind: SDKShapeKindUnion, Name: t.Name()}
+
+	case reflect.Struct:
+		shape := &SDKShape{Kind: SDKShapeKindStructure, Name: t.Name()}
+		if stack[t] {
+			shape.Recursive = true
+			return shape
+		}
+
+		stack[t] = true
+		defer delete(stack, t)
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Name == "ResultMetadata" {
+				continue
+			}
+
+			shape.Members = append(shape.Members, &SDKMember{
+				Name:  field.Name,
+				Shape: describeSDKShape(field.Type, stack),
+			})
+		}
+
+		return shape
+	}
+
+	return &SDKShape{Kind: SDKShapeKindString}
+}
+
+// sdkEnumValues returns the values of an enum type, or nil if the type has no Values method.
+func sdkEnumValues(t reflect.Type) []string {
+	method, ok := t.MethodByName("Values")
+	if !ok || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 || method.Type.Out(0).Kind() != reflect.Slice {
+		return nil
+	}
+
+	v := method.Func.Call([]reflect.Value{reflect.Zero(t)})[0]
+	values := make([]string, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).String()
+	}
+
+	return values
+}
+
+// populateSDKStruct allocates a structure's nested structures, and a single element of its lists of structures,
+// down to the specified depth so that the client's parameter validation reports their required members.
+// It returns whether any nested structures were left unallocated.
+func populateSDKStruct(v reflect.Value, depth int, stack map[reflect.Type]bool) bool {
+	t := v.Type()
+	if stack[t] {
+		return false
+	}
+
+	stack[t] = true
+	defer delete(stack, t)
+
+	var more bool
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fv := v.Field(i)
+		switch tField := field.Type; tField.Kind() {
+		case reflect.Ptr:
+			if tElem := tField.Elem(); tElem.Kind() == reflect.Struct && tElem != sdkTimeType {
+				if depth == 0 {
+					more = true
+					continue
+				}
+
+				p := reflect.New(tElem)
+				more = populateSDKStruct(p.Elem(), depth-1, stack) || more
+				fv.Set(p)
+			}
+
+		case reflect.Slice:
+			tElem := tField.Elem()
+			isPtr := tElem.Kind() == reflect.Ptr
+			if isPtr {
+				tElem = tElem.Elem()
+			}
+			if tElem.Kind() != reflect.Struct || tElem == sdkTimeType {
+				continue
+			}
+			if depth == 0 {
+				more = true
+				continue
+			}
+
+			p := reflect.New(tElem)
+			more = populateSDKStruct(p.Elem(), depth-1, stack) || more
+			s := reflect.MakeSlice(tField, 1, 1)
+			if isPtr {
+				s.Index(0).Set(p)
+			} else {
+				s.Index(0).Set(p.Elem())
+			}
+			fv.Set(s)
+		}
+	}
+
+	return more
+}
+
+// sdkOfflineHTTPClient fails every request, so that inspecting an operation never calls AWS.
+type sdkOfflineHTTPClient struct{}
+
+func (sdkOfflineHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("skaff does not send requests to AWS")
+}
+
+// setSDKOfflineOptions configures a service client's Options so that operations fail without calling AWS.
+func setSDKOfflineOptions(v reflect.Value) {
+	if f := v.FieldByName("Region"); f.IsValid() && f.Kind() == reflect.String && f.String() == "" {
+		f.SetString("us-west-2") //lintignore:AWSAT003
+	}
+	if f := v.FieldByName("RetryMaxAttempts"); f.IsValid() && f.Kind() == reflect.Int {
+		f.SetInt(1)
+	}
+	if f := v.FieldByName("HTTPClient"); f.IsValid() && reflect.TypeOf(sdkOfflineHTTPClient{}).AssignableTo(f.Type()) {
+		f.Set(reflect.ValueOf(sdkOfflineHTTPClient{}))
+	}
+}
+
+// sdkRequiredParameters returns the paths of the missing required parameters reported by a parameter validation error,
+// e.g. "RetentionPeriod.RetentionPeriodUnit".
+func sdkRequiredParameters(err error) []string {
+	var invalidParams interface {
+		Errs() []error
+	}
+	if !errors.As(err, &invalidParams) {
+		return nil
+	}
+
+	var paths []string
+	for _, err := range invalidParams.Errs() {
+		if !strings.Contains(err.Error(), "missing required field") {
+			continue
+		}
+
+		field, ok := err.(interface {
+			Field() string
+		})
+		if !ok {
+			continue
+		}
+
+		// Remove the input structure's name and any list indexes or map keys,
+		// e.g. "CreateRuleInput.ResourceTags[0].ResourceTagKey" -> "ResourceTags.ResourceTagKey".
+		path := sdkNestedContextRegexp.ReplaceAllString(field.Field(), "")
+		if _, path, ok = strings.Cut(path, "."); ok {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// markRequired marks the member at the specified path as required.
+func (s *SDKShape) markRequired(path []string) {
+	for s != nil && (s.Kind == SDKShapeKindList || s.Kind == SDKShapeKindMap) {
+		s = s.Elem
+	}
+
+	if len(path) == 0 {
+		return
+	}
+
+	m := s.Member(path[0])
+	if m == nil {
+		return
+	}
+
+	if len(path) == 1 {
+		m.Required = true
+		return
+	}
+
+	m.Shape.markRequired(path[1:])
+}
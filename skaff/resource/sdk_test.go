@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ivschat"
+	"github.com/aws/aws-sdk-go-v2/service/rbin"
+)
+
+func describeSDKOperations(t *testing.T, client any, ops SDKOperations) *sdkModel {
+	t.Helper()
+
+	ctx := context.Background()
+	describe := func(name string) *SDKOperation {
+		if name == "" {
+			return nil
+		}
+
+		op, err := DescribeSDKOperation(ctx, client, name)
+		if err != nil {
+			t.Fatalf("describing %s: %s", name, err)
+		}
+		if op == nil {
+			t.Fatalf("no operation %s", name)
+		}
+		return op
+	}
+
+	return &sdkModel{
+		Create: describe(ops.Create),
+		Read:   describe(ops.Read),
+		Update: describe(ops.Update),
+		Delete: describe(ops.Delete),
+		List:   describe(ops.List),
+	}
+}
+
+func TestDescribeSDKOperation(t *testing.T) {
+	ctx := context.Background()
+	client := rbin.New(rbin.Options{})
+
+	op, err := DescribeSDKOperation(ctx, client, "CreateRule")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		TestName string
+		Path     []string
+		Kind     SDKShapeKind
+		Required bool
+	}{
+		{
+			TestName: "required enum",
+			Path:     []string{"ResourceType"},
+			Kind:     SDKShapeKindEnum,
+			Required: true,
+		},
+		{
+			TestName: "required structure",
+			Path:     []string{"RetentionPeriod"},
+			Kind:     SDKShapeKindStructure,
+			Required: true,
+		},
+		{
+			TestName: "required member of required structure",
+			Path:     []string{"RetentionPeriod", "RetentionPeriodValue"},
+			Kind:     SDKShapeKindInteger,
+			Required: true,
+		},
+		{
+			TestName: "optional string",
+			Path:     []string{"Description"},
+			Kind:     SDKShapeKindString,
+		},
+		{
+			TestName: "optional structure",
+			Path:     []string{"LockConfiguration"},
+			Kind:     SDKShapeKindStructure,
+		},
+		{
+			TestName: "required member of optional nested structure",
+			Path:     []string{"LockConfiguration", "UnlockDelay", "UnlockDelayUnit"},
+			Kind:     SDKShapeKindEnum,
+			Required: true,
+		},
+		{
+			TestName: "list of structures",
+			Path:     []string{"ResourceTags"},
+			Kind:     SDKShapeKindList,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			shape := op.Input
+			var member *SDKMember
+			for _, name := range testCase.Path {
+				for shape.Kind == SDKShapeKindList {
+					shape = shape.Elem
+				}
+				if member = shape.Member(name); member == nil {
+					t.Fatalf("no member %s", strings.Join(testCase.Path, "."))
+				}
+				shape = member.Shape
+			}
+
+			if got, expected := member.Shape.Kind, testCase.Kind; got != expected {
+				t.Errorf("got kind %s, expected %s", got, expected)
+			}
+			if got, expected := member.Required, testCase.Required; got != expected {
+				t.Errorf("got required %t, expected %t", got, expected)
+			}
+		})
+	}
+
+	if got, expected := strings.Join(op.Input.Member("ResourceType").Shape.Values, ","), "EBS_SNAPSHOT,EC2_IMAGE"; got != expected {
+		t.Errorf("got enum values %s, expected %s", got, expected)
+	}
+	if member := op.Output.Member("ResultMetadata"); member != nil {
+		t.Error("expected ResultMetadata to be skipped")
+	}
+
+	op, err = DescribeSDKOperation(ctx, client, "NoSuchOperation")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if op != nil {
+		t.Errorf("expected no operation, got %v", op)
+	}
+}
+
+func TestDescribeSDKOperationTimestamps(t *testing.T) {
+	op, err := DescribeSDKOperation(context.Background(), ivschat.New(ivschat.Options{}), "GetRoom")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := op.Output.Member("CreateTime").Shape.Kind, SDKShapeKindTimestamp; got != expected {
+		t.Errorf("got kind %s, expected %s", got, expected)
+	}
+	if got, expected := op.Output.Member("Tags").Shape.Kind, SDKShapeKindMap; got != expected {
+		t.Errorf("got kind %s, expected %s", got, expected)
+	}
+	if !op.Input.Member("Identifier").Required {
+		t.Error("expected Identifier to be required")
+	}
+}
+
+func TestSDKGoName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "Name", Expected: "Name"},
+		{Input: "Arn", Expected: "ARN"},
+		{Input: "RoleArn", Expected: "RoleARN"},
+		{Input: "KmsKeyId", Expected: "KMSKeyID"},
+		{Input: "SubnetIds", Expected: "SubnetIDs"},
+		{Input: "Identifier", Expected: "Identifier"},
+		{Input: "Uri", Expected: "URI"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			if got := sdkGoName(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSDKEnumConstName(t *testing.T) {
+	testCases := []struct {
+		Enum     string
+		Value    string
+		Expected string
+	}{
+		{Enum: "RuleStatus", Value: "pending", Expected: "RuleStatusPending"},
+		{Enum: "StatusCode", Value: "IN_PROGRESS", Expected: "StatusCodeInProgress"},
+		{Enum: "State", Value: "UpdateFailed", Expected: "StateUpdateFailed"},
+		{Enum: "Type", Value: "ec2-image", Expected: "TypeEc2Image"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Value, func(t *testing.T) {
+			if got := sdkEnumConstName(testCase.Enum, testCase.Value); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRenderSDKResource(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		Client         any
+		ServicePackage string
+		Resource       string
+		Operations     SDKOperations
+		Expected       []string
+		NotExpected    []string
+	}{
+		{
+			TestName:       "rbin",
+			Client:         rbin.New(rbin.Options{}),
+			ServicePackage: "rbin",
+			Resource:       "Rule",
+			Operations: SDKOperations{
+				Create: "CreateRule",
+				Read:   "GetRule",
+				Update: "UpdateRule",
+				Delete: "DeleteRule",
+				List:   "ListRules",
+			},
+			Expected: []string{
+				`// @Tags(identifierAttribute="rule_arn")`,
+				`CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),`,
+				`"retention_period": schema.ListNestedBlock{`,
+				`listvalidator.IsRequired(),`,
+				`"lock_configuration": schema.ListNestedBlock{`,
+				`listplanmodifier.RequiresReplace(),`,
+				`Pending:                   enum.Slice(awstypes.RuleStatusPending),`,
+				`Target:                    enum.Slice(awstypes.RuleStatusAvailable),`,
+				`func waitRuleUpdated(`,
+				`func findRuleByID(ctx context.Context, conn *rbin.Client, id string) (*rbin.GetRuleOutput, error) {`,
+				`in.Identifier = aws.String(plan.ID.ValueString())`,
+				`flex.WithIgnoredFieldNames("Tags")`,
+				"type retentionPeriodModel struct {",
+				"fwtypes.ListNestedObjectValueOf[retentionPeriodModel]",
+				"struct {\n\tframework.WithRegionModel\n",
+			},
+			NotExpected: []string{
+				`"identifier":`,
+				"statusNormal",
+			},
+		},
+		{
+			TestName:       "ivschat",
+			Client:         ivschat.New(ivschat.Options{}),
+			ServicePackage: "ivschat",
+			Resource:       "Room",
+			Operations: SDKOperations{
+				Create: "CreateRoom",
+				Read:   "GetRoom",
+				Update: "UpdateRoom",
+				Delete: "DeleteRoom",
+				List:   "ListRooms",
+			},
+			Expected: []string{
+				`"arn": framework.ARNAttributeComputedOnly(),`,
+				`CustomType: fwtypes.TimestampType,`,
+				`CustomType:  fwtypes.ListOfStringType,`,
+				`CustomType: fwtypes.StringEnumType[awstypes.FallbackResult](),`,
+				`Target:                    []string{statusNormal},`,
+				`statusNormal = "Normal"`,
+				`setTagsOut(ctx, out.Tags)`,
+				`plan.ID = flex.StringToFramework(ctx, out.Arn)`,
+			},
+			NotExpected: []string{
+				`flex.WithIgnoredFieldNames`,
+				`func waitRoomUpdated(`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			td, err := newTemplateData(testCase.Resource, "", testCase.ServicePackage, false, true, true, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			data, err := newSDKTemplateData(td, testCase.ServicePackage, describeSDKOperations(t, testCase.Client, testCase.Operations))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			src, err := renderSDKTemplate("newres", resourceSDKTmpl, data, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s\n%s", err, src)
+			}
+
+			for _, expected := range testCase.Expected {
+				if !strings.Contains(string(src), expected) {
+					t.Errorf("resource does not contain %q:\n%s", expected, src)
+				}
+			}
+			for _, notExpected := range testCase.NotExpected {
+				if strings.Contains(string(src), notExpected) {
+					t.Errorf("resource contains %q", notExpected)
+				}
+			}
+
+			src, err = renderSDKTemplate("restest", resourceSDKTestTmpl, data, map[string]string{
+				"resource": "github.com/hashicorp/terraform-plugin-testing/helper/resource",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s\n%s", err, src)
+			}
+			if expected := "acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tf" + testCase.ServicePackage + ".Resource" + testCase.Resource + ", resourceName)"; !strings.Contains(string(src), expected) {
+				t.Errorf("test does not contain %q:\n%s", expected, src)
+			}
+
+			src, err = renderSDKTemplate("sweep", sweepSDKTmpl, data, map[string]string{
+				"framework": "github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+				"resource":  "github.com/hashicorp/terraform-plugin-testing/helper/resource",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s\n%s", err, src)
+			}
+			if expected := testCase.ServicePackage + ".New" + testCase.Operations.List + "Paginator(conn, in)"; !strings.Contains(string(src), expected) {
+				t.Errorf("sweeper does not contain %q:\n%s", expected, src)
+			}
+		})
+	}
+}
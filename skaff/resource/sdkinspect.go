@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed sdk.go
+var sdkDescriberSource string
+
+//go:embed sdkinspect.tmpl
+var sdkInspectorTmpl string
+
+// inspectSDKOperations describes the named operations of an AWS SDK for Go v2 service package.
+// skaff can't import every service package, so it writes a small program that does and runs it
+// from dir, which must be within the provider's Go module. Missing operations are returned as nil.
+func inspectSDKOperations(dir, sdkPackage string, operations []string) (map[string]*SDKOperation, error) {
+	tmp, err := os.MkdirTemp(dir, "_skaff-sdk-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	tmpl, err := template.New("sdkinspect").Parse(sdkInspectorTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	var main bytes.Buffer
+	if err := tmpl.Execute(&main, struct{ SDKPackage string }{sdkPackage}); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "main.go"), main.Bytes(), 0644); err != nil {
+		return nil, fmt.Errorf("writing inspector: %w", err)
+	}
+
+	describer := strings.Replace(sdkDescriberSource, "package resource", "package main", 1)
+	if err := os.WriteFile(filepath.Join(tmp, "sdk.go"), []byte(describer), 0644); err != nil {
+		return nil, fmt.Errorf("writing inspector: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", append([]string{"run", "./" + filepath.Base(tmp)}, operations...)...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("inspecting AWS SDK for Go v2 package %s: %w\n%s", sdkPackage, err, stderr.String())
+	}
+
+	var result map[string]*SDKOperation
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("reading AWS SDK for Go v2 package %s inspection: %w", sdkPackage, err)
+	}
+
+	return result, nil
+}
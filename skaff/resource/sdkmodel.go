@@ -0,0 +1,840 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// SDKOperations names the AWS SDK for Go v2 operations that implement a resource's lifecycle.
+type SDKOperations struct {
+	Create string
+	Read   string
+	Update string // Optional.
+	Delete string
+	List   string // Optional. Used by the sweeper.
+}
+
+// sdkModel is the inspected AWS SDK for Go v2 API model of a resource.
+type sdkModel struct {
+	Create, Read, Update, Delete, List *SDKOperation
+}
+
+// SDKTemplateData is the data used to render a resource generated from an AWS SDK for Go v2 API model.
+type SDKTemplateData struct {
+	TemplateData
+
+	SDKPackage              string
+	CreateOperation         string
+	ReadOperation           string
+	UpdateOperation         string
+	DeleteOperation         string
+	ListOperation           string
+	ListPaginated           bool
+	ListItems               string
+	ListItemID              string
+	ListRequired            []string
+	ModelName               string
+	IDMember                string
+	UpdateIDMember          string
+	DeleteIDMember          string
+	CreateIDMember          string
+	CreateToken             bool
+	DeleteToken             bool
+	DeleteRequired          []string
+	ReadRequired            []string
+	ReadResult              string
+	ReadResultType          string
+	ReadResultValueType     string
+	TagsIgnored             bool
+	TagsIdentifierAttribute string
+	SetTagsOut              bool
+	Attributes              []string
+	Blocks                  []string
+	Models                  []string
+	Updatable               []string
+	CheckAttributes         []string
+	ConfigArguments         []string
+	StatusMember            string
+	StatusEnum              string
+	PendingStatuses         string
+	TargetStatuses          string
+	DeletingStatuses        string
+	DefineStatusConst       bool
+}
+
+// HasStatus returns whether the resource has an enum status member that waiters can use.
+func (d SDKTemplateData) HasStatus() bool {
+	return d.StatusMember != ""
+}
+
+// sdkSkippedAttributes are Terraform attribute names that are added to every generated resource.
+// The "region" attribute is injected by the provider.
+var sdkSkippedAttributes = map[string]bool{
+	"id":       true,
+	"region":   true,
+	"tags":     true,
+	"tags_all": true,
+	"timeouts": true,
+}
+
+var (
+	sdkInitialismRegexp = regexp.MustCompile(`(Arn|Id|Kms|Url|Uri)(s?)([A-Z]|$)`)
+	sdkWordRegexp       = regexp.MustCompile(`[A-Za-z0-9]+`)
+)
+
+// sdkGoName returns the Go name of a model field for an AWS API structure member, e.g. "RoleArn" -> "RoleARN".
+func sdkGoName(member string) string {
+	return sdkInitialismRegexp.ReplaceAllStringFunc(member, func(s string) string {
+		m := sdkInitialismRegexp.FindStringSubmatch(s)
+		return strings.ToUpper(m[1]) + m[2] + m[3]
+	})
+}
+
+// sdkModelName returns the name of the model type for a nested AWS API structure, e.g. "RetentionPeriod" -> "retentionPeriodModel".
+func sdkModelName(name string) string {
+	if name == "" {
+		return "model"
+	}
+
+	r := []rune(name)
+	i := 0
+	for i < len(r) && unicode.IsUpper(r[i]) {
+		i++
+	}
+	// Keep the last upper case letter of a leading initialism, e.g. "KMSKey" -> "kmsKey".
+	if i > 1 && i < len(r) {
+		i--
+	}
+	return strings.ToLower(string(r[:i])) + string(r[i:]) + "Model"
+}
+
+// sdkEnumConstName returns the Go name of an AWS SDK for Go v2 enum constant, e.g. ("RuleStatus", "IN_PROGRESS") -> "RuleStatusInProgress".
+func sdkEnumConstName(enum, value string) string {
+	var sb strings.Builder
+	sb.WriteString(enum)
+	for _, word := range sdkWordRegexp.FindAllString(value, -1) {
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return sb.String()
+}
+
+// enumSlice returns an expression for the string values of the status enum's constants.
+func (d *SDKTemplateData) enumSlice(values []string) string {
+	if len(values) == 0 {
+		return "[]string{}"
+	}
+
+	consts := make([]string, len(values))
+	for i, v := range values {
+		consts[i] = "awstypes." + sdkEnumConstName(d.StatusEnum, v)
+	}
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(consts, ", "))
+}
+
+var (
+	sdkPendingStatusRegexp  = regexp.MustCompile(`(?i)pending|creating|updating|modifying|provisioning|starting|progress|requested`)
+	sdkTargetStatusRegexp   = regexp.MustCompile(`(?i)^(active|available|created|ready|completed?|succeeded|running|enabled|updated|in_?service)$`)
+	sdkDeletingStatusRegexp = regexp.MustCompile(`(?i)deleting`)
+)
+
+// newSDKTemplateData builds the template data for a resource from its inspected AWS SDK for Go v2 API model.
+func newSDKTemplateData(td TemplateData, sdkPackage string, m *sdkModel) (*SDKTemplateData, error) {
+	if m.Create == nil || m.Read == nil || m.Delete == nil {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	d := &SDKTemplateData{
+		TemplateData:    td,
+		SDKPackage:      sdkPackage,
+		CreateOperation: m.Create.Name,
+		ReadOperation:   m.Read.Name,
+		DeleteOperation: m.Delete.Name,
+		ModelName:       "resource" + td.Resource + "Model",
+	}
+
+	// The resource's identifier is the first required string input to the read operation.
+	for _, member := range m.Read.Input.Members {
+		if !member.Required {
+			continue
+		}
+		if d.IDMember == "" && member.Shape.Kind == SDKShapeKindString {
+			d.IDMember = member.Name
+			continue
+		}
+		d.ReadRequired = append(d.ReadRequired, member.Name)
+	}
+	if d.IDMember == "" {
+		return nil, fmt.Errorf("%s has no required string input member to identify the resource", m.Read.Name)
+	}
+
+	for _, name := range []string{d.IDMember, "Arn", "Id"} {
+		if member := m.Create.Output.Member(name); member != nil && member.Shape.Kind == SDKShapeKindString {
+			d.CreateIDMember = member.Name
+			break
+		}
+	}
+	// The identifier may be nested in a returned structure named for the resource.
+	if d.CreateIDMember == "" {
+		if member := m.Create.Output.Member(td.Resource); member != nil && member.Shape.Member(d.IDMember) != nil {
+			d.CreateIDMember = member.Name + "." + d.IDMember
+		}
+	}
+
+	// The resource's attributes are read from a returned structure named for the resource, if there is one.
+	result := m.Read.Output
+	d.ReadResult = "out"
+	d.ReadResultType = "*" + sdkPackage + "." + m.Read.Output.Name
+	if member := m.Read.Output.Member(td.Resource); member != nil && member.Shape.Kind == SDKShapeKindStructure {
+		result = member.Shape
+		d.ReadResult = "out." + member.Name
+		d.ReadResultType = "*awstypes." + member.Shape.Name
+	}
+
+	d.ReadResultValueType = strings.TrimPrefix(d.ReadResultType, "*")
+
+	if m.Update != nil {
+		d.UpdateOperation = m.Update.Name
+		d.UpdateIDMember = sdkIDMember(m.Update, d.IDMember)
+	}
+	d.DeleteIDMember = sdkIDMember(m.Delete, d.IDMember)
+
+	if member := m.Create.Input.Member("ClientToken"); member != nil {
+		d.CreateToken = true
+	}
+	if member := m.Delete.Input.Member("ClientToken"); member != nil {
+		d.DeleteToken = true
+	}
+	for _, member := range m.Delete.Input.Members {
+		if member.Required && member.Name != d.DeleteIDMember && member.Name != "ClientToken" {
+			d.DeleteRequired = append(d.DeleteRequired, member.Name)
+		}
+	}
+
+	// Tags are set by the transparent tagging interceptors rather than AutoFlEx.
+	if member := result.Member("Tags"); member != nil {
+		d.IncludeTags = true
+		d.SetTagsOut = true
+		d.TagsIgnored = member.Shape.Kind != SDKShapeKindMap
+	}
+	if member := m.Create.Input.Member("Tags"); member != nil {
+		d.IncludeTags = true
+		d.TagsIgnored = d.TagsIgnored || member.Shape.Kind != SDKShapeKindMap
+	}
+
+	b := &sdkSchemaBuilder{models: map[string]bool{}}
+	root := &sdkModelFields{name: d.ModelName, embedded: []string{"framework.WithRegionModel"}}
+
+	for _, member := range m.Create.Input.Members {
+		name := ToSnakeCase(member.Name, "")
+		if member.Name == "Tags" || member.Name == "ClientToken" || sdkSkippedAttributes[name] {
+			continue
+		}
+
+		a := sdkAttribute{
+			member:          member,
+			name:            name,
+			required:        member.Required,
+			optional:        !member.Required,
+			requiresReplace: m.Update == nil || m.Update.Input.Member(member.Name) == nil,
+		}
+		if a.optional && result.Member(member.Name) != nil {
+			a.computed = true
+		}
+		if !a.requiresReplace {
+			d.Updatable = append(d.Updatable, sdkGoName(member.Name))
+		}
+
+		b.attribute(root, a)
+	}
+
+	for _, member := range result.Members {
+		name := ToSnakeCase(member.Name, "")
+		if member.Name == "Tags" || member.Name == d.IDMember || sdkSkippedAttributes[name] || m.Create.Input.Member(member.Name) != nil {
+			continue
+		}
+
+		b.attribute(root, sdkAttribute{
+			member:   member,
+			name:     name,
+			computed: true,
+		})
+	}
+
+	root.fields = append(root.fields, "ID types.String `tfsdk:\"id\"`")
+	root.attributes = append(root.attributes, `"id": framework.IDAttribute(),`)
+	if d.IncludeTags {
+		root.fields = append(root.fields, "Tags types.Map `tfsdk:\"tags\"`", "TagsAll types.Map `tfsdk:\"tags_all\"`")
+		root.attributes = append(root.attributes, "names.AttrTags: tftags.TagsAttribute(),", "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),")
+	}
+	root.fields = append(root.fields, "Timeouts timeouts.Value `tfsdk:\"timeouts\"`")
+
+	// Tags are managed by the resource's ARN, e.g. "arn" or "rule_arn".
+	d.TagsIdentifierAttribute = "arn"
+	for _, member := range result.Members {
+		if member.Shape.Kind == SDKShapeKindString && strings.HasSuffix(member.Name, "Arn") {
+			d.TagsIdentifierAttribute = ToSnakeCase(member.Name, "")
+			d.CheckAttributes = append(d.CheckAttributes, d.TagsIdentifierAttribute)
+			break
+		}
+	}
+	d.CheckAttributes = append(d.CheckAttributes, "id")
+
+	d.ConfigArguments = sdkConfigArguments(m.Create.Input, "  ")
+	if !slices.ContainsFunc(d.ConfigArguments, func(s string) bool { return strings.Contains(s, "%[1]q") }) {
+		if d.IncludeTags {
+			if len(d.ConfigArguments) > 0 {
+				d.ConfigArguments = append(d.ConfigArguments, "")
+			}
+			d.ConfigArguments = append(d.ConfigArguments, "tags = {", "  Name = %[1]q", "}")
+		} else {
+			d.ConfigArguments = append(d.ConfigArguments, "# TODO Use the random name (%[1]q) for a unique argument.")
+		}
+	}
+
+	sort.Strings(root.attributes)
+	sort.Strings(root.blocks)
+	d.Attributes = root.attributes
+	d.Blocks = root.blocks
+	d.Models = append([]string{root.render()}, b.rendered...)
+
+	// Waiters use an enum status member, e.g. "Status" or "RuleState".
+	for _, member := range result.Members {
+		if member.Shape.Kind != SDKShapeKindEnum || !(strings.HasSuffix(member.Name, "Status") || strings.HasSuffix(member.Name, "State")) {
+			continue
+		}
+
+		var pending, target, deleting []string
+		for _, v := range member.Shape.Values {
+			switch {
+			case sdkDeletingStatusRegexp.MatchString(v):
+				deleting = append(deleting, v)
+			case sdkPendingStatusRegexp.MatchString(v):
+				pending = append(pending, v)
+			case sdkTargetStatusRegexp.MatchString(v):
+				target = append(target, v)
+			}
+		}
+		if len(target) == 0 {
+			continue
+		}
+
+		d.StatusMember = member.Name
+		d.StatusEnum = member.Shape.Name
+		d.PendingStatuses = d.enumSlice(pending)
+		d.TargetStatuses = d.enumSlice(target)
+		d.DeletingStatuses = d.enumSlice(append(target, deleting...))
+		break
+	}
+	d.DefineStatusConst = !d.HasStatus()
+
+	if m.List != nil {
+		for _, member := range m.List.Output.Members {
+			if member.Shape.Kind != SDKShapeKindList || member.Shape.Elem.Kind != SDKShapeKindStructure {
+				continue
+			}
+
+			for _, name := range []string{d.IDMember, "Arn", "Id"} {
+				if item := member.Shape.Elem.Member(name); item != nil && item.Shape.Kind == SDKShapeKindString {
+					d.ListOperation = m.List.Name
+					d.ListItems = member.Name
+					d.ListItemID = item.Name
+					break
+				}
+			}
+			break
+		}
+
+		d.ListPaginated = m.List.Input.Member("NextToken") != nil && m.List.Output.Member("NextToken") != nil
+		for _, member := range m.List.Input.Members {
+			if member.Required {
+				d.ListRequired = append(d.ListRequired, member.Name)
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// sdkConfigArguments returns the lines of a Terraform configuration that sets a structure's required members.
+// Nested lines are indented relative to the first level.
+func sdkConfigArguments(shape *SDKShape, indent string) []string {
+	var lines []string
+
+	for _, member := range shape.Members {
+		name := ToSnakeCase(member.Name, "")
+		if !member.Required || member.Name == "Tags" || member.Name == "ClientToken" || sdkSkippedAttributes[name] {
+			continue
+		}
+
+		switch s := member.Shape; s.Kind {
+		case SDKShapeKindBoolean:
+			lines = append(lines, name+" = false")
+		case SDKShapeKindInteger, SDKShapeKindFloat:
+			lines = append(lines, name+" = 1")
+		case SDKShapeKindEnum:
+			lines = append(lines, fmt.Sprintf("%s = %q", name, s.Values[0]))
+		case SDKShapeKindString:
+			if name == "name" && indent == "  " {
+				lines = append(lines, "name = %[1]q")
+			} else {
+				lines = append(lines, fmt.Sprintf("# TODO %s = ...", name))
+			}
+		case SDKShapeKindList, SDKShapeKindStructure:
+			if s.Kind == SDKShapeKindList {
+				s = s.Elem
+			}
+			if s.Kind != SDKShapeKindStructure || s.Recursive {
+				lines = append(lines, fmt.Sprintf("# TODO %s = [...]", name))
+				continue
+			}
+
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, name+" {")
+			for _, line := range sdkConfigArguments(s, indent+"  ") {
+				if line != "" {
+					line = "  " + line
+				}
+				lines = append(lines, line)
+			}
+			lines = append(lines, "}")
+		default:
+			lines = append(lines, fmt.Sprintf("# TODO %s = ...", name))
+		}
+	}
+
+	return lines
+}
+
+// sdkIDMember returns the name of the input member that identifies the resource to an operation.
+// It's the read operation's identifier member if the operation has it, otherwise the first required string member.
+func sdkIDMember(op *SDKOperation, readIDMember string) string {
+	if op.Input.Member(readIDMember) != nil {
+		return readIDMember
+	}
+
+	for _, member := range op.Input.Members {
+		if member.Required && member.Shape.Kind == SDKShapeKindString {
+			return member.Name
+		}
+	}
+
+	return readIDMember
+}
+
+// sdkAttribute is a Terraform attribute or block generated from an AWS API structure member.
+type sdkAttribute struct {
+	member          *SDKMember
+	name            string
+	required        bool
+	optional        bool
+	computed        bool
+	requiresReplace bool
+}
+
+// sdkModelFields accumulates the fields and schema of a (nested) model type.
+type sdkModelFields struct {
+	name       string
+	embedded   []string
+	fields     []string
+	attributes []string
+	blocks     []string
+}
+
+func (f *sdkModelFields) render() string {
+	sort.Strings(f.fields)
+	return fmt.Sprintf("type %s struct {\n%s\n}", f.name, strings.Join(append(slices.Clone(f.embedded), f.fields...), "\n"))
+}
+
+type sdkSchemaBuilder struct {
+	models   map[string]bool
+	rendered []string
+}
+
+func (b *sdkSchemaBuilder) field(f *sdkModelFields, a sdkAttribute, goType string) {
+	f.fields = append(f.fields, fmt.Sprintf("%s %s `tfsdk:%q`", sdkGoName(a.member.Name), goType, a.name))
+}
+
+func (b *sdkSchemaBuilder) todo(f *sdkModelFields, a sdkAttribute, reason string) {
+	f.attributes = append(f.attributes, fmt.Sprintf("// TODO %q: %s.", a.name, reason))
+}
+
+// attribute adds the schema and model field for a member.
+func (b *sdkSchemaBuilder) attribute(f *sdkModelFields, a sdkAttribute) {
+	shape := a.member.Shape
+
+	if a.name == "arn" && a.computed && !a.optional {
+		b.field(f, a, "types.String")
+		f.attributes = append(f.attributes, `"arn": framework.ARNAttributeComputedOnly(),`)
+		return
+	}
+
+	switch shape.Kind {
+	case SDKShapeKindBoolean:
+		b.field(f, a, "types.Bool")
+		f.attributes = append(f.attributes, b.scalar(a, "Bool", "", "bool"))
+
+	case SDKShapeKindInteger:
+		b.field(f, a, "types.Int64")
+		f.attributes = append(f.attributes, b.scalar(a, "Int64", "", "int64"))
+
+	case SDKShapeKindFloat:
+		b.field(f, a, "types.Float64")
+		f.attributes = append(f.attributes, b.scalar(a, "Float64", "", "float64"))
+
+	case SDKShapeKindString, SDKShapeKindBlob:
+		b.field(f, a, "types.String")
+		f.attributes = append(f.attributes, b.scalar(a, "String", "", "string"))
+
+	case SDKShapeKindEnum:
+		b.field(f, a, fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", shape.Name))
+		f.attributes = append(f.attributes, b.scalar(a, "String", fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", shape.Name), "string"))
+
+	case SDKShapeKindTimestamp:
+		b.field(f, a, "fwtypes.Timestamp")
+		f.attributes = append(f.attributes, b.scalar(a, "String", "fwtypes.TimestampType", "string"))
+
+	case SDKShapeKindDocument:
+		b.field(f, a, "fwtypes.SmithyJSON[document.Interface]")
+		f.attributes = append(f.attributes, b.scalar(a, "String", "fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument)", "string"))
+
+	case SDKShapeKindList, SDKShapeKindMap:
+		b.collection(f, a)
+
+	case SDKShapeKindStructure:
+		b.structure(f, a, true)
+
+	case SDKShapeKindUnion:
+		b.todo(f, a, fmt.Sprintf("union %s; implement flex.TaggedUnion on a nested model with one block per member", shape.Name))
+
+	default:
+		b.todo(f, a, fmt.Sprintf("unsupported %s", shape.Kind))
+	}
+}
+
+// scalar renders a primitive attribute's schema.
+func (b *sdkSchemaBuilder) scalar(a sdkAttribute, kind, customType, planModifierPackage string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%q: schema.%sAttribute{\n", a.name, kind)
+	if customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	}
+	b.flags(&sb, a)
+	b.planModifiers(&sb, a, kind, planModifierPackage)
+	sb.WriteString("},")
+
+	return sb.String()
+}
+
+func (b *sdkSchemaBuilder) flags(sb *strings.Builder, a sdkAttribute) {
+	if a.required {
+		sb.WriteString("Required: true,\n")
+	}
+	if a.optional {
+		sb.WriteString("Optional: true,\n")
+	}
+	if a.computed {
+		sb.WriteString("Computed: true,\n")
+	}
+}
+
+func (b *sdkSchemaBuilder) planModifiers(sb *strings.Builder, a sdkAttribute, kind, pkg string) {
+	var modifiers []string
+	if a.requiresReplace {
+		modifiers = append(modifiers, pkg+"planmodifier.RequiresReplace()")
+	}
+	if a.computed {
+		modifiers = append(modifiers, pkg+"planmodifier.UseStateForUnknown()")
+	}
+	if len(modifiers) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n%s,\n},\n", kind, strings.Join(modifiers, ",\n"))
+}
+
+// collection adds a list or map member.
+func (b *sdkSchemaBuilder) collection(f *sdkModelFields, a sdkAttribute) {
+	shape := a.member.Shape
+	elem := shape.Elem
+
+	if shape.Kind == SDKShapeKindList && elem.Kind == SDKShapeKindStructure {
+		b.structure(f, a, false)
+		return
+	}
+
+	var kind, customType, goType string
+	switch shape.Kind {
+	case SDKShapeKindList:
+		kind, customType, goType = "List", "fwtypes.ListOfStringType", "fwtypes.ListValueOf[types.String]"
+	default:
+		kind, customType, goType = "Map", "fwtypes.NewMapTypeOf[types.String](ctx)", "fwtypes.MapValueOf[types.String]"
+	}
+
+	switch elem.Kind {
+	case SDKShapeKindString, SDKShapeKindEnum:
+	default:
+		b.todo(f, a, fmt.Sprintf("%s of %s", shape.Kind, elem.Kind))
+		return
+	}
+
+	b.field(f, a, goType)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%q: schema.%sAttribute{\n", a.name, kind)
+	fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	sb.WriteString("ElementType: types.StringType,\n")
+	b.flags(&sb, a)
+	b.planModifiers(&sb, a, kind, strings.ToLower(kind))
+	if elem.Kind == SDKShapeKindEnum {
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n%svalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.%s]()),\n},\n", kind, strings.ToLower(kind), elem.Name)
+	}
+	sb.WriteString("},")
+
+	f.attributes = append(f.attributes, sb.String())
+}
+
+// structure adds a nested structure, or list of structures, member.
+// Input structures become list nested blocks and output-only structures become computed list attributes.
+func (b *sdkSchemaBuilder) structure(f *sdkModelFields, a sdkAttribute, single bool) {
+	shape := a.member.Shape
+	if !single {
+		shape = shape.Elem
+	}
+	if shape.Recursive {
+		b.todo(f, a, fmt.Sprintf("recursive structure %s", shape.Name))
+		return
+	}
+
+	model := sdkModelName(shape.Name)
+	b.field(f, a, fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model))
+
+	// Models are rendered in the order that they're first used.
+	i, render := len(b.rendered), !b.models[model]
+	if render {
+		b.models[model] = true
+		b.rendered = append(b.rendered, "")
+	}
+
+	nested := &sdkModelFields{name: model}
+	for _, member := range shape.Members {
+		name := ToSnakeCase(member.Name, "")
+		if a.computed && !a.optional {
+			b.attribute(nested, sdkAttribute{member: member, name: name, computed: true})
+		} else {
+			b.attribute(nested, sdkAttribute{member: member, name: name, required: member.Required, optional: !member.Required})
+		}
+	}
+	if render {
+		b.rendered[i] = nested.render()
+	}
+
+	var sb strings.Builder
+
+	if a.computed && !a.optional {
+		fmt.Fprintf(&sb, "%q: schema.ListAttribute{\n", a.name)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model)
+		sb.WriteString("Computed: true,\n")
+		fmt.Fprintf(&sb, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", model)
+		b.planModifiers(&sb, sdkAttribute{computed: true}, "List", "list")
+		sb.WriteString("},")
+		f.attributes = append(f.attributes, sb.String())
+		return
+	}
+
+	fmt.Fprintf(&sb, "%q: schema.ListNestedBlock{\n", a.name)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model)
+	if a.requiresReplace {
+		sb.WriteString("PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n},\n")
+	}
+	var validators []string
+	if single {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if a.required {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if len(validators) > 0 {
+		fmt.Fprintf(&sb, "Validators: []validator.List{\n%s,\n},\n", strings.Join(validators, ",\n"))
+	}
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	sort.Strings(nested.attributes)
+	if len(nested.attributes) > 0 {
+		fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n%s\n},\n", strings.Join(nested.attributes, "\n"))
+	}
+	sort.Strings(nested.blocks)
+	if len(nested.blocks) > 0 {
+		fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n%s\n},\n", strings.Join(nested.blocks, "\n"))
+	}
+	sb.WriteString("},\n},")
+
+	f.blocks = append(f.blocks, sb.String())
+}
+
+// sdkImportCandidates returns the packages that generated code may use, by package name.
+// An aliased package's value is prefixed with its alias.
+func sdkImportCandidates(servicePackage, sdkPackage string, overrides map[string]string) map[string]string {
+	candidates := map[string]string{
+		"context":             "context",
+		"errors":              "errors",
+		"fmt":                 "fmt",
+		"log":                 "log",
+		"testing":             "testing",
+		"time":                "time",
+		"aws":                 "github.com/aws/aws-sdk-go-v2/aws",
+		sdkPackage:            "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage,
+		"awstypes":            "awstypes github.com/aws/aws-sdk-go-v2/service/" + sdkPackage + "/types",
+		"document":            "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage + "/document",
+		"timeouts":            "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts",
+		"listvalidator":       "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+		"mapvalidator":        "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+		"resource":            "github.com/hashicorp/terraform-plugin-framework/resource",
+		"schema":              "github.com/hashicorp/terraform-plugin-framework/resource/schema",
+		"planmodifier":        "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier",
+		"boolplanmodifier":    "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+		"float64planmodifier": "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier",
+		"int64planmodifier":   "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier",
+		"listplanmodifier":    "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier",
+		"mapplanmodifier":     "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier",
+		"stringplanmodifier":  "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+		"validator":           "github.com/hashicorp/terraform-plugin-framework/schema/validator",
+		"types":               "github.com/hashicorp/terraform-plugin-framework/types",
+		"id":                  "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id",
+		"retry":               "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry",
+		"sdkacctest":          "sdkacctest github.com/hashicorp/terraform-plugin-testing/helper/acctest",
+		"terraform":           "github.com/hashicorp/terraform-plugin-testing/terraform",
+		"acctest":             "github.com/hashicorp/terraform-provider-aws/internal/acctest",
+		"conns":               "github.com/hashicorp/terraform-provider-aws/internal/conns",
+		"create":              "github.com/hashicorp/terraform-provider-aws/internal/create",
+		"enum":                "github.com/hashicorp/terraform-provider-aws/internal/enum",
+		"errs":                "github.com/hashicorp/terraform-provider-aws/internal/errs",
+		"framework":           "github.com/hashicorp/terraform-provider-aws/internal/framework",
+		"flex":                "github.com/hashicorp/terraform-provider-aws/internal/framework/flex",
+		"fwtypes":             "fwtypes github.com/hashicorp/terraform-provider-aws/internal/framework/types",
+		"sweep":               "github.com/hashicorp/terraform-provider-aws/internal/sweep",
+		"awsv2":               "github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2",
+		"tftags":              "tftags github.com/hashicorp/terraform-provider-aws/internal/tags",
+		"tfresource":          "github.com/hashicorp/terraform-provider-aws/internal/tfresource",
+		"tf" + servicePackage: "tf" + servicePackage + " github.com/hashicorp/terraform-provider-aws/internal/service/" + servicePackage,
+		"names":               "github.com/hashicorp/terraform-provider-aws/names",
+	}
+
+	for k, v := range overrides {
+		candidates[k] = v
+	}
+
+	return candidates
+}
+
+const sdkImportsPlaceholder = "// IMPORTS"
+
+var sdkIdentifierRegexp = regexp.MustCompile(`\b([a-z][a-z0-9]*)\.[A-Za-z]`)
+
+// sdkImports returns the import declaration for the packages used by generated code.
+// Standard library packages are grouped first.
+func sdkImports(body []byte, candidates map[string]string) string {
+	used := map[string]bool{}
+	for _, m := range sdkIdentifierRegexp.FindAllSubmatch(body, -1) {
+		used[string(m[1])] = true
+	}
+
+	var std, other []string
+	for name, spec := range candidates {
+		if !used[name] {
+			continue
+		}
+
+		path, alias := spec, ""
+		if before, after, ok := strings.Cut(spec, " "); ok {
+			alias, path = before, after
+		}
+
+		line := fmt.Sprintf("%q", path)
+		if alias != "" {
+			line = alias + " " + line
+		}
+
+		if strings.Contains(path, ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+
+	sortImports := func(s []string) {
+		sort.Slice(s, func(i, j int) bool {
+			return sdkImportPath(s[i]) < sdkImportPath(s[j])
+		})
+	}
+	sortImports(std)
+	sortImports(other)
+
+	var sb strings.Builder
+	sb.WriteString("import (\n")
+	for _, s := range std {
+		sb.WriteString("\t" + s + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, s := range other {
+		sb.WriteString("\t" + s + "\n")
+	}
+	sb.WriteString(")\n")
+
+	return sb.String()
+}
+
+func sdkImportPath(s string) string {
+	_, path, _ := strings.Cut(s, `"`)
+	return path
+}
+
+// renderSDKTemplate renders a template, adds the imports that its body uses, and formats the result.
+// Unformattable output is returned along with the formatting error so that it can be fixed by hand.
+func renderSDKTemplate(name, tmpl string, d *SDKTemplateData, imports map[string]string) ([]byte, error) {
+	t, err := template.New(name).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := t.Execute(&body, d); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	// The imports replace a placeholder line after the package clause.
+	header, rest, ok := bytes.Cut(body.Bytes(), []byte(sdkImportsPlaceholder+"\n"))
+	if !ok {
+		return nil, fmt.Errorf("template %s has no imports placeholder", name)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(header)
+	buf.WriteString(sdkImports(rest, sdkImportCandidates(d.ServicePackage, d.SDKPackage, imports)))
+	buf.Write(rest)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
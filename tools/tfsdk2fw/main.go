@@ -17,18 +17,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	apiName            = flag.String("api", "", "AWS API resource name used in CRUD operation names, e.g. PodIdentityAssociation (default <name>)")
+	dataSourceType     = flag.String("data-source", "", "Data Source type")
+	migrateFromVersion = flag.String("migrate-from-version", "5.33.0", "Last provider version implementing the resource with the Plugin SDK")
+	resourceType       = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [flags] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
 }
 
 func main() {
@@ -53,15 +58,21 @@ func main() {
 	// }
 	g := common.NewGenerator()
 	migrator := &migrator{
-		Generator:   g,
-		Name:        name,
-		PackageName: packageName,
+		APIName:            name,
+		Generator:          g,
+		MigrateFromVersion: *migrateFromVersion,
+		Name:               name,
+		PackageName:        packageName,
+	}
+
+	if v := *apiName; v != "" {
+		migrator.APIName = v
 	}
 
 	p, err := provider.New(context.Background())
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -84,6 +95,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -93,16 +105,21 @@ func main() {
 }
 
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	APIName            string
+	Generator          *common.Generator
+	IsDataSource       bool
+	MigrateFromVersion string
+	Name               string
+	PackageName        string
+	Resource           *schema.Resource
+	Template           string
+	TestTemplate       string
+	TFTypeName         string
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, CRUD handlers are generated alongside the schema and a state migration acceptance test
+// is generated into a corresponding _test.go file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -126,6 +143,24 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_test.go"
+
+	m.infof("generating into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("test", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
@@ -146,6 +181,7 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
+		APIName:                      m.APIName,
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
@@ -153,16 +189,23 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasNameAttribute:             slices.Contains(emitter.RequiredAttributes, "name"),
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		MigrateFromVersion:           m.MigrateFromVersion,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		RequiredAttributes:           emitter.RequiredAttributes,
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if err := m.setServiceTemplateData(templateData); err != nil {
+		return nil, err
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -182,6 +225,39 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// setServiceTemplateData sets the template data describing the resource's service and AWS SDK for Go v2 client.
+// Unknown services are reported and generated with placeholder values.
+func (m *migrator) setServiceTemplateData(templateData *templateData) error {
+	templateData.ClientMethod = "TODOClient"
+	templateData.HumanName = naming.ToHumanName(m.Name)
+	templateData.ProviderNameUpper = "TODO"
+	templateData.SDKPackage = m.PackageName
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	providerNameUpper, err := names.ProviderNameUpper(m.PackageName)
+
+	if err != nil {
+		m.Generator.Warnf("service %s: %s", m.PackageName, err)
+
+		return nil
+	}
+
+	sdkPackage, err := names.AWSGoV2Package(m.PackageName)
+
+	if err != nil {
+		return err
+	}
+
+	templateData.ClientMethod = providerNameUpper + "Client"
+	templateData.ProviderNameUpper = providerNameUpper
+	templateData.SDKPackage = sdkPackage
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
@@ -201,12 +277,16 @@ type emitter struct {
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
+	RequiredAttributes            []string // Names of Required top-level attributes.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
+	// The "region" attribute is injected by the provider. The generated model embeds framework.WithRegionModel.
+	delete(resource.Schema, names.AttrRegion)
+
 	if _, ok := resource.Schema["id"]; ok {
 		e.warnf("Explicit `id` attribute defined")
 	} else {
@@ -513,6 +593,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	if property.Required {
 		fprintf(e.SchemaWriter, "Required:true,\n")
+
+		if isTopLevelAttribute {
+			e.RequiredAttributes = append(e.RequiredAttributes, attributeName)
+		}
 	}
 
 	if property.Optional {
@@ -883,6 +967,8 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	APIName                       string // e.g. PodIdentityAssociation
+	ClientMethod                  string // e.g. EKSClient
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -892,13 +978,19 @@ type templateData struct {
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
+	HasNameAttribute              bool // Whether the resource has a required top-level "name" attribute.
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
+	HumanName                     string // e.g. Pod Identity Association
 	ImportProviderFrameworkTypes  bool
+	MigrateFromVersion            string // e.g. 5.33.0
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
 	ProviderPlanModifierPackages  []string
+	RequiredAttributes            []string
 	Schema                        string
+	SDKPackage                    string // e.g. ec2
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -908,3 +1000,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed resource_test.tmpl
+var resourceTestImpl string
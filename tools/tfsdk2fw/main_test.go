@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestMigrateResource(t *testing.T) {
+	ctx := context.Background()
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resource, ok := p.ResourcesMap["aws_eks_addon"]
+	if !ok {
+		t.Fatal("resource type aws_eks_addon not found")
+	}
+
+	outputFilename := filepath.Join(t.TempDir(), "addon_fw.go")
+	m := &migrator{
+		APIName:            "Addon",
+		Generator:          common.NewGenerator(),
+		MigrateFromVersion: "5.33.0",
+		Name:               "AddonFW",
+		PackageName:        "eks",
+		Resource:           resource,
+		Template:           resourceImpl,
+		TestTemplate:       resourceTestImpl,
+		TFTypeName:         "aws_eks_addon",
+	}
+
+	if err := m.migrate(outputFilename); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_test.go"
+
+	src, err := os.ReadFile(outputFilename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		`response.TypeName = "aws_eks_addon"`,
+		`"addon_name": schema.StringAttribute{`,
+		`"cluster_name": schema.StringAttribute{`,
+		`stringplanmodifier.RequiresReplace(),`,
+		`s.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{`,
+		"type resourceAddonFWData struct {\n\tframework.WithRegionModel\n",
+		"AddonName ",
+		`output, err := conn.CreateAddon(ctx, input)`,
+		`// TODO Set data.ID from the output.`,
+		`func findAddonFWByID(ctx context.Context, conn *eks.Client, id string) (*eks.DescribeAddonOutput, error) {`,
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("resource does not contain %q:\n%s", expected, src)
+		}
+	}
+	for _, notExpected := range []string{
+		`types.StringValue("TODO")`,
+		`"region":`,
+	} {
+		if strings.Contains(string(src), notExpected) {
+			t.Errorf("resource contains %q", notExpected)
+		}
+	}
+
+	src, err = os.ReadFile(testFilename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		`func TestAccEKSAddonFW_MigrateFromPluginSDK(t *testing.T) {`,
+		`acctest.ParallelTest(t, resource.TestCase{`,
+		`VersionConstraint: "5.33.0",`,
+		`# TODO Use the random name (%[1]q) for a unique argument.`,
+		`# TODO addon_name = ...`,
+		`# TODO cluster_name = ...`,
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("test does not contain %q:\n%s", expected, src)
+		}
+	}
+
+	if testing.Short() {
+		t.Skip("skipping compilation of generated code in short mode")
+	}
+
+	// Compile and vet the generated code as part of the service package, without writing to the source tree.
+	dir, err := filepath.Abs(filepath.Join("..", "..", "internal", "service", "eks"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	overlay, err := json.Marshal(map[string]map[string]string{
+		"Replace": {
+			filepath.Join(dir, "addon_fw.go"):      outputFilename,
+			filepath.Join(dir, "addon_fw_test.go"): testFilename,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	overlayFilename := filepath.Join(t.TempDir(), "overlay.json")
+	if err := os.WriteFile(overlayFilename, overlay, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "vet", "-overlay", overlayFilename, ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("generated code does not compile: %s\n%s", err, out)
+	}
+}
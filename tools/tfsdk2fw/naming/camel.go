@@ -45,6 +45,28 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToHumanName converts a CamelCase string to space-separated words, e.g. "PodIdentityAssociation" to "Pod Identity Association".
+// Runs of capital letters, e.g. "VPC", are kept together.
+func ToHumanName(s string) string {
+	b := []byte(strings.TrimSpace(s))
+	c := strings.Builder{}
+
+	for i, ch := range b {
+		if i > 0 && isCapitalLetter(ch) {
+			prev := b[i-1]
+			nextIsLow := i+1 < len(b) && isLowercaseLetter(b[i+1])
+
+			if isLowercaseLetter(prev) || isNumeric(prev) || (isCapitalLetter(prev) && nextIsLow) {
+				c.WriteByte(' ')
+			}
+		}
+
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
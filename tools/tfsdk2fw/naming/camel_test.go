@@ -67,3 +67,47 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToHumanName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Instance",
+			ExpectedValue: "Instance",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "PodIdentityAssociation",
+			ExpectedValue: "Pod Identity Association",
+		},
+		{
+			TestName:      "acronym",
+			Value:         "VPCEndpoint",
+			ExpectedValue: "VPC Endpoint",
+		},
+		{
+			TestName:      "trailing acronym",
+			Value:         "SecurityGroupVPCAssociation",
+			ExpectedValue: "Security Group VPC Association",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToHumanName(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}